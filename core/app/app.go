@@ -6,8 +6,10 @@ import (
 	"github.com/iotaledger/hive.go/core/app/plugins/profiling"
 	"github.com/iotaledger/inx-api-core-v0/core/coreapi"
 	"github.com/iotaledger/inx-api-core-v0/core/database"
+	"github.com/iotaledger/inx-api-core-v0/plugins/grpcapi"
 	"github.com/iotaledger/inx-api-core-v0/plugins/inx"
 	"github.com/iotaledger/inx-api-core-v0/plugins/prometheus"
+	"github.com/iotaledger/inx-api-core-v0/plugins/tracing"
 )
 
 var (
@@ -28,8 +30,10 @@ func App() *app.App {
 		}...),
 		app.WithPlugins([]*app.Plugin{
 			inx.Plugin,
+			grpcapi.Plugin,
 			profiling.Plugin,
 			prometheus.Plugin,
+			tracing.Plugin,
 		}...),
 	)
 }
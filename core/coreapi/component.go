@@ -3,16 +3,25 @@ package coreapi
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/labstack/gommon/bytes"
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/hive.go/core/app"
+	"github.com/iotaledger/inx-api-core-v0/pkg/admission"
+	"github.com/iotaledger/inx-api-core-v0/pkg/auth"
+	"github.com/iotaledger/inx-api-core-v0/pkg/coalesce"
 	"github.com/iotaledger/inx-api-core-v0/pkg/daemon"
 	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/health"
+	"github.com/iotaledger/inx-api-core-v0/pkg/httpcache"
+	"github.com/iotaledger/inx-api-core-v0/pkg/ratelimit"
 	"github.com/iotaledger/inx-api-core-v0/pkg/server"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 )
@@ -30,11 +39,19 @@ func init() {
 	}
 }
 
+const (
+	// rateLimiterCleanupInterval is the interval in which idle rate limiter buckets are removed.
+	rateLimiterCleanupInterval = 1 * time.Minute
+	// rateLimiterMaxIdleTime is the time after which the rate limiter bucket of an idle client is removed.
+	rateLimiterMaxIdleTime = 10 * time.Minute
+)
+
 type dependencies struct {
 	dig.In
-	AppInfo  *app.Info
-	Database *database.Database
-	Echo     *echo.Echo
+	Echo        *echo.Echo
+	RateLimiter *ratelimit.RateLimiter
+	// the routes are registered on the echo instance by the database server
+	DatabaseServer *server.DatabaseServer
 }
 
 var (
@@ -48,12 +65,21 @@ func initConfigPars(c *dig.Container) error {
 		dig.Out
 		RestAPIBindAddress      string `name:"restAPIBindAddress"`
 		RestAPIAdvertiseAddress string `name:"restAPIAdvertiseAddress"`
+		// the gRPC API applies the same limits as the REST API
+		RestAPIRateLimitEnabled        bool                            `name:"restAPIRateLimitEnabled"`
+		RestAPIAuthenticationEnabled   bool                            `name:"restAPIAuthenticationEnabled"`
+		RestAPIAdmissionControlEnabled bool                            `name:"restAPIAdmissionControlEnabled"`
+		RestAPIOperationClassCost      func(server.OperationClass) int `name:"restAPIOperationClassCost"`
 	}
 
 	if err := c.Provide(func() cfgResult {
 		return cfgResult{
-			RestAPIBindAddress:      ParamsRestAPI.BindAddress,
-			RestAPIAdvertiseAddress: ParamsRestAPI.AdvertiseAddress,
+			RestAPIBindAddress:             ParamsRestAPI.BindAddress,
+			RestAPIAdvertiseAddress:        ParamsRestAPI.AdvertiseAddress,
+			RestAPIRateLimitEnabled:        ParamsRestAPI.RateLimit.Enabled,
+			RestAPIAuthenticationEnabled:   ParamsRestAPI.Authentication.Enabled,
+			RestAPIAdmissionControlEnabled: ParamsRestAPI.AdmissionControl.Enabled,
+			RestAPIOperationClassCost:      operationClassCost,
 		}
 	}); err != nil {
 		CoreComponent.LogPanic(err)
@@ -64,38 +90,313 @@ func initConfigPars(c *dig.Container) error {
 
 func provide(c *dig.Container) error {
 
-	if err := c.Provide(func() *echo.Echo {
+	if err := c.Provide(func(db *database.Database) *health.Checker {
+		// other components add their own checks to the health checker
+		healthChecker := health.New()
+		healthChecker.AddLivenessCheck("database", db.CheckStoresReadable)
+		healthChecker.AddReadinessCheck("ledger", db.CheckLedger)
+
+		return healthChecker
+	}); err != nil {
+		return err
+	}
+
+	if err := c.Provide(func() (*ratelimit.RateLimiter, error) {
+		if ParamsRestAPI.RateLimit.Enabled {
+			if err := validateRateLimitParams(); err != nil {
+				return nil, err
+			}
+		}
+
+		return ratelimit.New(
+			ratelimit.NewLimiter(ParamsRestAPI.RateLimit.IPTokensPerSecond, ParamsRestAPI.RateLimit.IPBurst),
+			ratelimit.NewLimiter(ParamsRestAPI.RateLimit.APIKeyTokensPerSecond, ParamsRestAPI.RateLimit.APIKeyBurst),
+			ParamsRestAPI.RateLimit.APIKeys,
+			requestCost,
+		), nil
+	}); err != nil {
+		return err
+	}
+
+	if err := c.Provide(func() (*admission.Controller, error) {
+		controller := admission.New(
+			ParamsRestAPI.AdmissionControl.QueueTimeout,
+			func(c echo.Context) string {
+				return string(server.OperationClassForRequest(c))
+			},
+		)
+
+		if !ParamsRestAPI.AdmissionControl.Enabled {
+			return controller, nil
+		}
+
+		for class, limits := range map[server.OperationClass]struct{ maxConcurrent, maxQueued int }{
+			server.OperationClassFindTransactions:   {ParamsRestAPI.AdmissionControl.FindTransactions.MaxConcurrent, ParamsRestAPI.AdmissionControl.FindTransactions.MaxQueued},
+			server.OperationClassLedgerDiffExtended: {ParamsRestAPI.AdmissionControl.LedgerDiffExtended.MaxConcurrent, ParamsRestAPI.AdmissionControl.LedgerDiffExtended.MaxQueued},
+			server.OperationClassLedgerState:        {ParamsRestAPI.AdmissionControl.LedgerState.MaxConcurrent, ParamsRestAPI.AdmissionControl.LedgerState.MaxQueued},
+		} {
+			if err := controller.AddQueue(string(class), limits.maxConcurrent, limits.maxQueued); err != nil {
+				return nil, fmt.Errorf("invalid admission control limits: %w", err)
+			}
+		}
+
+		return controller, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := c.Provide(func() *auth.Authenticator {
+		return auth.New(
+			ParamsRestAPI.Authentication.APIKeys,
+			ParamsRestAPI.Authentication.JWTSecret,
+			// the health probes are always public, unknown routes are never public
+			append([]string{string(server.PermissionScopePublic)}, ParamsRestAPI.Authentication.PublicScopes...),
+			func(c echo.Context) []string {
+				permissionScopes := server.PermissionScopesForRequest(c)
+
+				scopes := make([]string, 0, len(permissionScopes))
+				for _, scope := range permissionScopes {
+					scopes = append(scopes, string(scope))
+				}
+
+				return scopes
+			},
+		)
+	}); err != nil {
+		return err
+	}
+
+	if err := c.Provide(func() (*coalesce.Coalescer, error) {
+		maxResponseSize, err := bytes.Parse(ParamsRestAPI.Coalescing.MaxResponseSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max response size of the coalescing: %w", err)
+		}
+
+		return coalesce.New(server.CoalescingKeyForRequest, int(maxResponseSize)), nil
+	}); err != nil {
+		return err
+	}
+
+	type echoDeps struct {
+		dig.In
+		AppInfo             *app.Info
+		RateLimiter         *ratelimit.RateLimiter
+		Authenticator       *auth.Authenticator
+		Database            *database.Database
+		Coalescer           *coalesce.Coalescer
+		AdmissionController *admission.Controller
+		// the tracing and metrics middlewares are provided by the tracing and prometheus plugins, if they are enabled
+		TracingMiddleware    echo.MiddlewareFunc `name:"restAPITracingMiddleware" optional:"true"`
+		MetricsMiddleware    echo.MiddlewareFunc `name:"restAPIMetricsMiddleware" optional:"true"`
+		RPCMetricsMiddleware echo.MiddlewareFunc `name:"restAPIRPCMetricsMiddleware" optional:"true"`
+	}
+
+	if err := c.Provide(func(deps echoDeps) (*echo.Echo, error) {
+		ipExtractor, err := newIPExtractor(ParamsRestAPI.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
+
 		e := httpserver.NewEcho(
 			CoreComponent.Logger(),
 			nil,
 			ParamsRestAPI.DebugRequestLoggerEnabled,
 		)
+		e.IPExtractor = ipExtractor
+
+		// the tracing and metrics middlewares are registered first, so rejected requests are recorded as well
+		if deps.TracingMiddleware != nil {
+			e.Use(deps.TracingMiddleware)
+		}
+
+		if deps.MetricsMiddleware != nil {
+			e.Use(deps.MetricsMiddleware)
+		}
+
 		e.Use(middleware.Gzip())
 		e.Use(middleware.BodyLimit(ParamsRestAPI.Limits.MaxBodyLength))
 
-		return e
+		if deps.RPCMetricsMiddleware != nil {
+			// the RPC command is read from the body, so the body has to be limited already
+			e.Use(deps.RPCMetricsMiddleware)
+		}
+
+		if ParamsRestAPI.RateLimit.Enabled {
+			e.Use(deps.RateLimiter.Middleware())
+		}
+
+		if ParamsRestAPI.Authentication.Enabled {
+			e.Use(deps.Authenticator.Middleware())
+		}
+
+		if ParamsRestAPI.HTTPCache.Enabled {
+			cache := httpcache.New(
+				func(c echo.Context) string {
+					return server.CacheKeyForRequest(c, deps.Database.GetLedgerIndex())
+				},
+				deps.AppInfo.Version,
+				ParamsRestAPI.HTTPCache.MaxAge,
+			)
+			e.Use(cache.Middleware())
+		}
+
+		if ParamsRestAPI.Coalescing.Enabled {
+			// coalesced requests wait for the running call and don't occupy a slot of the admission control
+			e.Use(deps.Coalescer.Middleware())
+		}
+
+		if ParamsRestAPI.AdmissionControl.Enabled {
+			// requests are only queued after they passed the rate limiter and the authentication
+			e.Use(deps.AdmissionController.Middleware())
+		}
+
+		return e, nil
 	}); err != nil {
 		return err
 	}
 
-	return nil
-}
+	type databaseServerDeps struct {
+		dig.In
+		AppInfo       *app.Info
+		Database      *database.Database
+		HealthChecker *health.Checker
+		Echo          *echo.Echo
+	}
 
-func run() error {
+	// the gRPC API answers its queries with the same server as the REST API
+	if err := c.Provide(func(deps databaseServerDeps) (*server.DatabaseServer, error) {
+		// the limit was already validated by the body limit middleware
+		maxBodyLength, err := bytes.Parse(ParamsRestAPI.Limits.MaxBodyLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max body length: %w", err)
+		}
 
-	// create a background worker that handles the API
-	if err := CoreComponent.Daemon().BackgroundWorker("API", func(ctx context.Context) {
-		CoreComponent.LogInfo("Starting API server ...")
+		milestoneVerification, err := database.NewMilestoneVerificationSettings(ParamsRestAPI.MilestoneVerification.MerkleTreeHashFunc, ParamsRestAPI.MilestoneVerification.MerkleTreeDepth)
+		if err != nil {
+			return nil, fmt.Errorf("invalid milestone verification settings: %w", err)
+		}
 
 		swagger := server.CreateEchoSwagger(deps.Echo, deps.AppInfo.Version, ParamsRestAPI.SwaggerEnabled)
 
-		//nolint:contextcheck //false positive
-		_ = server.NewDatabaseServer(
+		return server.NewDatabaseServer(
 			swagger,
 			deps.AppInfo,
 			deps.Database,
+			deps.HealthChecker,
 			ParamsRestAPI.Limits.MaxResults,
-		)
+			maxBodyLength,
+			ParamsRestAPI.Limits.MaxLedgerDiffRange,
+			ParamsRestAPI.Batch.MaxSize,
+			ParamsRestAPI.Batch.MaxConcurrent,
+			ParamsRestAPI.GraphQL.Enabled,
+			ParamsRestAPI.GraphQL.MaxDepth,
+			milestoneVerification,
+		), nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newIPExtractor returns the extractor of the client IP of requests.
+// The X-Forwarded-For header is only trusted if the request was sent by one of the given proxies,
+// otherwise every client could choose its own IP and get a new bucket of the rate limiter.
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	trustOptions := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, trustedProxy := range trustedProxies {
+		if trustedProxy == "" {
+			continue
+		}
+
+		_, ipRange, err := net.ParseCIDR(trustedProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %w", err)
+		}
+		trustOptions = append(trustOptions, echo.TrustIPRange(ipRange))
+	}
+
+	if len(trustOptions) == 3 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	return echo.ExtractIPFromXFFHeader(trustOptions...), nil
+}
+
+// validateRateLimitParams checks that the buckets are refilled and that every operation and every batch fits into a bucket.
+func validateRateLimitParams() error {
+	if ParamsRestAPI.RateLimit.IPTokensPerSecond <= 0 || ParamsRestAPI.RateLimit.APIKeyTokensPerSecond <= 0 {
+		return errors.New("the tokens per second of the rate limiter must be greater than zero")
+	}
+
+	maxCost := 0
+	maxBatchableCost := 0
+	for _, class := range []server.OperationClass{
+		server.OperationClassDefault,
+		server.OperationClassFindTransactions,
+		server.OperationClassLedgerDiff,
+		server.OperationClassLedgerDiffExtended,
+		server.OperationClassLedgerState,
+	} {
+		cost := operationClassCost(class)
+		if cost > maxCost {
+			maxCost = cost
+		}
+
+		if server.IsBatchableOperationClass(class) && cost > maxBatchableCost {
+			maxBatchableCost = cost
+		}
+	}
+
+	// a batch consumes the tokens of all its commands at once
+	if maxBatchCost := ParamsRestAPI.Batch.MaxSize * maxBatchableCost; maxBatchCost > maxCost {
+		maxCost = maxBatchCost
+	}
+
+	if ParamsRestAPI.RateLimit.IPBurst < maxCost || ParamsRestAPI.RateLimit.APIKeyBurst < maxCost {
+		return fmt.Errorf("the burst of the rate limiter must be at least the highest cost of an operation or a batch of the maximum size: %d", maxCost)
+	}
+
+	return nil
+}
+
+// requestCost returns the amount of rate limiter tokens a request consumes.
+// Batch RPC requests consume the tokens of all their commands.
+func requestCost(c echo.Context) int {
+	cost := 0
+	for _, class := range server.OperationClassesForRequest(c) {
+		cost += operationClassCost(class)
+	}
+
+	return cost
+}
+
+// operationClassCost returns the amount of rate limiter tokens an operation of the given class consumes.
+func operationClassCost(class server.OperationClass) int {
+	switch class {
+	case server.OperationClassFindTransactions:
+		return ParamsRestAPI.RateLimit.Costs.FindTransactions
+	case server.OperationClassLedgerState:
+		return ParamsRestAPI.RateLimit.Costs.LedgerState
+	case server.OperationClassLedgerDiff:
+		return ParamsRestAPI.RateLimit.Costs.LedgerDiff
+	case server.OperationClassLedgerDiffExtended:
+		return ParamsRestAPI.RateLimit.Costs.LedgerDiffExtended
+	default:
+		return ParamsRestAPI.RateLimit.Costs.Default
+	}
+}
+
+func run() error {
+
+	// create a background worker that handles the API
+	if err := CoreComponent.Daemon().BackgroundWorker("API", func(ctx context.Context) {
+		CoreComponent.LogInfo("Starting API server ...")
 
 		go func() {
 			CoreComponent.LogInfof("You can now access the API using: http://%s", ParamsRestAPI.BindAddress)
@@ -121,5 +422,23 @@ func run() error {
 		CoreComponent.LogPanicf("failed to start worker: %s", err)
 	}
 
+	if ParamsRestAPI.RateLimit.Enabled {
+		if err := CoreComponent.Daemon().BackgroundWorker("API rate limiter cleanup", func(ctx context.Context) {
+			ticker := time.NewTicker(rateLimiterCleanupInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					deps.RateLimiter.Cleanup(rateLimiterMaxIdleTime)
+				}
+			}
+		}, daemon.PriorityStopDatabaseAPI); err != nil {
+			CoreComponent.LogPanicf("failed to start worker: %s", err)
+		}
+	}
+
 	return nil
 }
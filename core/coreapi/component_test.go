@@ -0,0 +1,40 @@
+package coreapi
+
+import (
+	"testing"
+)
+
+func TestValidateRateLimitParams(t *testing.T) {
+	tests := []struct {
+		name      string
+		batchSize int
+		ipBurst   int
+		wantErr   bool
+	}{
+		{name: "batch fits into the burst", batchSize: 10, ipBurst: 100},
+		{name: "ledger state fits into the burst", batchSize: 1, ipBurst: 100},
+		{name: "batch exceeds the burst", batchSize: 11, ipBurst: 100, wantErr: true},
+		{name: "ledger state exceeds the burst", batchSize: 1, ipBurst: 99, wantErr: true},
+	}
+
+	defer func(params ParametersRestAPI) { *ParamsRestAPI = params }(*ParamsRestAPI)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ParamsRestAPI.Batch.MaxSize = tt.batchSize
+			ParamsRestAPI.RateLimit.IPTokensPerSecond = 10
+			ParamsRestAPI.RateLimit.IPBurst = tt.ipBurst
+			ParamsRestAPI.RateLimit.APIKeyTokensPerSecond = 100
+			ParamsRestAPI.RateLimit.APIKeyBurst = 1000
+			ParamsRestAPI.RateLimit.Costs.Default = 1
+			ParamsRestAPI.RateLimit.Costs.FindTransactions = 5
+			ParamsRestAPI.RateLimit.Costs.LedgerDiff = 10
+			ParamsRestAPI.RateLimit.Costs.LedgerDiffExtended = 50
+			ParamsRestAPI.RateLimit.Costs.LedgerState = 100
+
+			if err := validateRateLimitParams(); (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
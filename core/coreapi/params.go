@@ -1,6 +1,8 @@
 package coreapi
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/core/app"
 )
 
@@ -12,11 +14,118 @@ type ParametersRestAPI struct {
 	// AdvertiseAddress defines the address of the legacy API HTTP server which is advertised to the INX Server (optional).
 	AdvertiseAddress string `default:"" usage:"the address of the legacy API HTTP server which is advertised to the INX Server (optional)"`
 
+	// TrustedProxies defines the CIDR ranges of the reverse proxies whose X-Forwarded-For header is trusted to determine the client IP.
+	TrustedProxies []string `default:"" usage:"the CIDR ranges of the reverse proxies whose X-Forwarded-For header is trusted to determine the client IP (the IP of the connection is used if empty)"`
+
 	Limits struct {
 		// the maximum number of characters that the body of an API call may contain
 		MaxBodyLength string `default:"1M" usage:"the maximum number of characters that the body of an API call may contain"`
 		// the maximum number of results that may be returned by an endpoint
 		MaxResults int `default:"1000" usage:"the maximum number of results that may be returned by an endpoint"`
+		// the maximum number of milestones whose ledger diffs are composed by a single request
+		MaxLedgerDiffRange int `default:"100" usage:"the maximum number of milestones whose ledger diffs are composed by a single request"`
+	}
+
+	Batch struct {
+		// the maximum number of commands in a batch RPC request
+		MaxSize int `default:"10" usage:"the maximum number of commands in a batch RPC request (a batch of the maximum size has to fit into the burst of the rate limiter)"`
+		// the maximum number of commands of a batch RPC request that are executed concurrently
+		MaxConcurrent int `default:"4" usage:"the maximum number of commands of a batch RPC request that are executed concurrently"`
+	}
+
+	Authentication struct {
+		// Enabled defines whether the authentication of API requests is enabled
+		Enabled bool `default:"false" usage:"whether the authentication of API requests is enabled"`
+		// the static API keys that grant access to all routes
+		APIKeys []string `name:"apiKeys" default:"" usage:"the static API keys that grant access to all routes"`
+		// the secret to verify JWT bearer tokens with
+		JWTSecret string `name:"jwtSecret" default:"" usage:"the secret to verify JWT bearer tokens with (bearer tokens are rejected if empty)"`
+		// the permission scopes that can be accessed without credentials
+		PublicScopes []string `default:"info,transactions,addresses,ledgerDiff" usage:"the permission scopes that can be accessed without credentials (the health probes are always public, unknown routes never)"`
+	}
+
+	RateLimit struct {
+		// Enabled defines whether the rate limiting of API requests is enabled
+		Enabled bool `default:"false" usage:"whether the rate limiting of API requests is enabled"`
+		// the amount of tokens that are refilled per second into the bucket of every client IP
+		IPTokensPerSecond int `name:"ipTokensPerSecond" default:"10" usage:"the amount of tokens that are refilled per second into the bucket of every client IP"`
+		// the maximum amount of tokens in the bucket of every client IP
+		IPBurst int `name:"ipBurst" default:"100" usage:"the maximum amount of tokens in the bucket of every client IP"`
+		// the amount of tokens that are refilled per second into the bucket of every API key
+		APIKeyTokensPerSecond int `name:"apiKeyTokensPerSecond" default:"100" usage:"the amount of tokens that are refilled per second into the bucket of every API key"`
+		// the maximum amount of tokens in the bucket of every API key
+		APIKeyBurst int `name:"apiKeyBurst" default:"1000" usage:"the maximum amount of tokens in the bucket of every API key"`
+		// the API keys that are limited by the API key limits instead of the client IP
+		APIKeys []string `name:"apiKeys" default:"" usage:"the API keys that are limited by the API key limits instead of the client IP"`
+
+		Costs struct {
+			// the amount of tokens consumed by cheap requests
+			Default int `default:"1" usage:"the amount of tokens consumed by cheap requests"`
+			// the amount of tokens consumed by transaction searches and tangle walks
+			FindTransactions int `default:"5" usage:"the amount of tokens consumed by transaction searches and tangle walks"`
+			// the amount of tokens consumed by ledger diff requests
+			LedgerDiff int `default:"10" usage:"the amount of tokens consumed by ledger diff requests"`
+			// the amount of tokens consumed by extended ledger diff requests
+			LedgerDiffExtended int `default:"50" usage:"the amount of tokens consumed by extended ledger diff requests"`
+			// the amount of tokens consumed by ledger state requests
+			LedgerState int `default:"100" usage:"the amount of tokens consumed by ledger state requests"`
+		}
+	}
+
+	HTTPCache struct {
+		// Enabled defines whether immutable responses are sent with ETag and Cache-Control headers
+		Enabled bool `default:"true" usage:"whether immutable responses are sent with ETag and Cache-Control headers"`
+		// the duration clients and proxies may cache immutable responses
+		MaxAge time.Duration `default:"8760h" usage:"the duration clients and proxies may cache immutable responses"`
+	} `name:"httpCache"`
+
+	Coalescing struct {
+		// Enabled defines whether identical concurrent expensive API requests share a single computation
+		Enabled bool `default:"true" usage:"whether identical concurrent expensive API requests share a single computation"`
+		// MaxResponseSize defines the maximum size of a response that is shared with identical concurrent requests
+		MaxResponseSize string `default:"10M" usage:"the maximum size of a response that is shared with identical concurrent requests"`
+	}
+
+	AdmissionControl struct {
+		// Enabled defines whether the concurrency of expensive API requests is limited
+		Enabled bool `default:"false" usage:"whether the concurrency of expensive API requests is limited"`
+		// the maximum time a request waits in the queue before it is rejected
+		QueueTimeout time.Duration `default:"10s" usage:"the maximum time a request waits in the queue before it is rejected"`
+
+		FindTransactions struct {
+			// the maximum amount of concurrently processed transaction searches and tangle walks
+			MaxConcurrent int `default:"16" usage:"the maximum amount of concurrently processed transaction searches and tangle walks"`
+			// the maximum amount of transaction searches and tangle walks waiting in the queue
+			MaxQueued int `default:"64" usage:"the maximum amount of transaction searches and tangle walks waiting in the queue"`
+		}
+
+		LedgerDiffExtended struct {
+			// the maximum amount of concurrently processed extended ledger diff requests
+			MaxConcurrent int `default:"4" usage:"the maximum amount of concurrently processed extended ledger diff requests"`
+			// the maximum amount of extended ledger diff requests waiting in the queue
+			MaxQueued int `default:"16" usage:"the maximum amount of extended ledger diff requests waiting in the queue"`
+		}
+
+		LedgerState struct {
+			// the maximum amount of concurrently processed ledger state requests
+			MaxConcurrent int `default:"2" usage:"the maximum amount of concurrently processed ledger state requests"`
+			// the maximum amount of ledger state requests waiting in the queue
+			MaxQueued int `default:"8" usage:"the maximum amount of ledger state requests waiting in the queue"`
+		}
+	}
+
+	GraphQL struct {
+		// Enabled defines whether to provide the GraphQL endpoint under "/graphql"
+		Enabled bool `default:"false" usage:"whether to provide the GraphQL endpoint under \"/graphql\""`
+		// the maximum depth of a GraphQL query
+		MaxDepth int `default:"10" usage:"the maximum depth of a GraphQL query"`
+	} `name:"graphQL"`
+
+	MilestoneVerification struct {
+		// the hash function the coordinator used to sign the milestones and to build its Merkle tree
+		MerkleTreeHashFunc string `default:"CURLP27" usage:"the hash function the coordinator used to sign the milestones and to build its Merkle tree (KERL, CURLP27, CURLP81)"`
+		// the depth of the Merkle tree of the coordinator
+		MerkleTreeDepth int `default:"24" usage:"the depth of the Merkle tree of the coordinator"`
 	}
 
 	// SwaggerEnabled defines whether to provide swagger API documentation under endpoint "/swagger"
@@ -32,5 +141,9 @@ var params = &app.ComponentParams{
 	Params: map[string]any{
 		"restAPI": ParamsRestAPI,
 	},
-	Masked: nil,
+	Masked: []string{
+		"restAPI.authentication.apiKeys",
+		"restAPI.authentication.jwtSecret",
+		"restAPI.rateLimit.apiKeys",
+	},
 }
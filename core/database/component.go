@@ -44,25 +44,43 @@ var (
 
 func provide(c *dig.Container) error {
 
-	if err := c.Provide(func() (*database.Database, error) {
+	type databaseOut struct {
+		dig.Out
+		Database       *database.Database
+		DatabaseStores map[string]*engine.Store `name:"databaseStores"`
+	}
+
+	if err := c.Provide(func() (databaseOut, error) {
 		CoreComponent.LogInfo("Setting up database ...")
 
 		tangleDatabase, err := engine.StoreWithDefaultSettings(ParamsDatabase.Tangle.Path, false, hivedb.EngineAuto, "tangle.db", engine.AllowedEnginesStorageAuto...)
 		if err != nil {
-			return nil, err
+			return databaseOut{}, err
 		}
 
 		snapshotDatabase, err := engine.StoreWithDefaultSettings(ParamsDatabase.Snapshot.Path, false, hivedb.EngineAuto, "snapshot.db", engine.AllowedEnginesStorageAuto...)
 		if err != nil {
-			return nil, err
+			return databaseOut{}, err
 		}
 
 		spentDatabase, err := engine.StoreWithDefaultSettings(ParamsDatabase.Spent.Path, false, hivedb.EngineAuto, "spent.db", engine.AllowedEnginesStorageAuto...)
 		if err != nil {
-			return nil, err
+			return databaseOut{}, err
+		}
+
+		db, err := database.New(tangleDatabase, snapshotDatabase, spentDatabase, ParamsDatabase.Debug)
+		if err != nil {
+			return databaseOut{}, err
 		}
 
-		return database.New(tangleDatabase, snapshotDatabase, spentDatabase, ParamsDatabase.Debug)
+		return databaseOut{
+			Database: db,
+			DatabaseStores: map[string]*engine.Store{
+				"tangle":   tangleDatabase,
+				"snapshot": snapshotDatabase,
+				"spent":    spentDatabase,
+			},
+		}, nil
 	}); err != nil {
 		return err
 	}
@@ -0,0 +1,186 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+)
+
+var (
+	// ErrQueueFull is returned if the wait queue of an operation class is full.
+	ErrQueueFull = errors.New("too many queued requests")
+	// ErrQueueTimeout is returned if a request waited too long in the queue of an operation class.
+	ErrQueueTimeout = errors.New("timeout while waiting in queue")
+)
+
+// ClassFunc returns the operation class of a request.
+type ClassFunc func(c echo.Context) string
+
+// queue limits the amount of concurrently processed requests of an operation class.
+type queue struct {
+	slots     chan struct{}
+	maxQueued int32
+	queued    *atomic.Int32
+}
+
+func newQueue(maxConcurrent int, maxQueued int) *queue {
+	return &queue{
+		slots:     make(chan struct{}, maxConcurrent),
+		maxQueued: int32(maxQueued),
+		queued:    atomic.NewInt32(0),
+	}
+}
+
+// Controller limits the amount of concurrently processed requests per operation class
+// and lets additional requests wait in a bounded queue.
+type Controller struct {
+	queues       map[string]*queue
+	queueTimeout time.Duration
+	classFunc    ClassFunc
+
+	inFlight         *prometheus.GaugeVec
+	queued           *prometheus.GaugeVec
+	rejectedRequests *prometheus.CounterVec
+}
+
+// New creates a new Controller. Requests of operation classes without a queue are not limited.
+func New(queueTimeout time.Duration, classFunc ClassFunc) *Controller {
+	return &Controller{
+		queues:       make(map[string]*queue),
+		queueTimeout: queueTimeout,
+		classFunc:    classFunc,
+		inFlight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "iota",
+				Subsystem: "restapi",
+				Name:      "admission_in_flight_requests",
+				Help:      "The number of requests that are currently processed per operation class.",
+			},
+			[]string{"class"},
+		),
+		queued: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "iota",
+				Subsystem: "restapi",
+				Name:      "admission_queued_requests",
+				Help:      "The number of requests that are currently waiting in the queue per operation class.",
+			},
+			[]string{"class"},
+		),
+		rejectedRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "iota",
+				Subsystem: "restapi",
+				Name:      "admission_rejected_requests_total",
+				Help:      "The number of requests that were rejected by the admission control per operation class.",
+			},
+			[]string{"class", "reason"},
+		),
+	}
+}
+
+// AddQueue limits the requests of the given operation class.
+// It must be called before the middleware is used.
+// At least one request must be processed concurrently, otherwise every request would wait until the queue timeout.
+func (a *Controller) AddQueue(class string, maxConcurrent int, maxQueued int) error {
+	if maxConcurrent <= 0 {
+		return fmt.Errorf("the maximum amount of concurrently processed requests of operation class %s must be greater than zero", class)
+	}
+
+	if maxQueued < 0 {
+		return fmt.Errorf("the maximum amount of queued requests of operation class %s must not be negative", class)
+	}
+
+	a.queues[class] = newQueue(maxConcurrent, maxQueued)
+
+	return nil
+}
+
+// Collectors returns the prometheus collectors of the Controller.
+func (a *Controller) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{a.inFlight, a.queued, a.rejectedRequests}
+}
+
+// acquire waits until a slot of the queue is free or the queue timeout is reached.
+func (a *Controller) acquire(ctx context.Context, class string, q *queue) error {
+	select {
+	case q.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if q.queued.Inc() > q.maxQueued {
+		q.queued.Dec()
+
+		return ErrQueueFull
+	}
+
+	a.queued.WithLabelValues(class).Inc()
+	defer func() {
+		q.queued.Dec()
+		a.queued.WithLabelValues(class).Dec()
+	}()
+
+	timer := time.NewTimer(a.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case q.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return ErrQueueTimeout
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Acquire waits for a free slot of the queue of the given operation class.
+// The returned function releases the slot and must be called after the request was processed.
+// Requests of operation classes without a queue are not limited.
+func (a *Controller) Acquire(ctx context.Context, class string) (func(), error) {
+	q, limited := a.queues[class]
+	if !limited {
+		return func() {}, nil
+	}
+
+	if err := a.acquire(ctx, class, q); err != nil {
+		reason := "canceled"
+		switch {
+		case errors.Is(err, ErrQueueFull):
+			reason = "queueFull"
+		case errors.Is(err, ErrQueueTimeout):
+			reason = "queueTimeout"
+		}
+		a.rejectedRequests.WithLabelValues(class, reason).Inc()
+
+		return nil, err
+	}
+
+	a.inFlight.WithLabelValues(class).Inc()
+
+	return func() {
+		a.inFlight.WithLabelValues(class).Dec()
+		<-q.slots
+	}, nil
+}
+
+// Middleware returns an echo middleware that limits the concurrently processed requests per operation class.
+func (a *Controller) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			release, err := a.Acquire(c.Request().Context(), a.classFunc(c))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+			}
+			defer release()
+
+			return next(c)
+		}
+	}
+}
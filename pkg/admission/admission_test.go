@@ -0,0 +1,148 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestControllerAcquire(t *testing.T) {
+	tests := []struct {
+		name          string
+		class         string
+		maxConcurrent int
+		maxQueued     int
+		// held is the amount of slots that are acquired before the tested call
+		held    int
+		wantErr error
+	}{
+		{
+			name:          "class without queue",
+			class:         "other",
+			maxConcurrent: 1,
+			held:          10,
+		},
+		{
+			name:          "free slot",
+			class:         "limited",
+			maxConcurrent: 2,
+			held:          1,
+		},
+		{
+			name:          "queue timeout",
+			class:         "limited",
+			maxConcurrent: 1,
+			maxQueued:     1,
+			held:          1,
+			wantErr:       ErrQueueTimeout,
+		},
+		{
+			name:          "queue full",
+			class:         "limited",
+			maxConcurrent: 1,
+			maxQueued:     0,
+			held:          1,
+			wantErr:       ErrQueueFull,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New(10*time.Millisecond, nil)
+			if err := a.AddQueue("limited", tt.maxConcurrent, tt.maxQueued); err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < tt.held; i++ {
+				release, err := a.Acquire(context.Background(), tt.class)
+				if err != nil {
+					t.Fatalf("acquire %d: %v", i, err)
+				}
+				defer release()
+			}
+
+			release, err := a.Acquire(context.Background(), tt.class)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil {
+				release()
+			}
+		})
+	}
+}
+
+func TestControllerAddQueue(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxConcurrent int
+		maxQueued     int
+		wantErr       bool
+	}{
+		{name: "valid limits", maxConcurrent: 1, maxQueued: 0},
+		{name: "no concurrent requests", maxConcurrent: 0, maxQueued: 1, wantErr: true},
+		{name: "negative concurrent requests", maxConcurrent: -1, maxQueued: 1, wantErr: true},
+		{name: "negative queued requests", maxConcurrent: 1, maxQueued: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New(10*time.Millisecond, nil)
+
+			err := a.AddQueue("limited", tt.maxConcurrent, tt.maxQueued)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %t", err, tt.wantErr)
+			}
+
+			if _, limited := a.queues["limited"]; limited == tt.wantErr {
+				t.Fatalf("got queue: %t, want queue: %t", limited, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestControllerAcquireAfterRelease(t *testing.T) {
+	a := New(time.Second, nil)
+	if err := a.AddQueue("limited", 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	release, err := a.Acquire(context.Background(), "limited")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		release()
+	}()
+
+	// the queued request gets the slot of the released one
+	release, err = a.Acquire(context.Background(), "limited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+}
+
+func TestControllerAcquireCanceled(t *testing.T) {
+	a := New(time.Second, nil)
+	if err := a.AddQueue("limited", 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	release, err := a.Acquire(context.Background(), "limited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := a.Acquire(ctx, "limited"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// HeaderAPIKey is the header that contains the static API key of a client.
+	HeaderAPIKey = "X-API-Key"
+
+	// ScopeAll grants access to all permission scopes.
+	ScopeAll = "*"
+
+	bearerPrefix = "Bearer "
+)
+
+var (
+	// ErrUnauthorized is returned if the credentials of a request are missing or invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is returned if the credentials of a request don't grant a needed permission scope.
+	ErrForbidden = errors.New("forbidden")
+)
+
+// ScopeFunc returns the permission scopes that are needed to access the route of a request.
+// Empty scopes mean the route is not protected.
+type ScopeFunc func(c echo.Context) []string
+
+// Claims are the claims of the JWT bearer tokens accepted by the Authenticator.
+type Claims struct {
+	jwt.StandardClaims
+
+	// Scopes are the permission scopes granted to the bearer of the token.
+	Scopes []string `json:"scopes"`
+}
+
+// Authenticator checks the credentials of requests against the permission scopes of the requested route.
+type Authenticator struct {
+	apiKeys      [][]byte
+	jwtSecret    []byte
+	publicScopes map[string]struct{}
+	scopeFunc    ScopeFunc
+}
+
+// New creates a new Authenticator.
+// Static API keys grant access to all scopes, JWT bearer tokens grant access to the scopes in their claims.
+// Tokens are only accepted if a JWT secret is given.
+func New(apiKeys []string, jwtSecret string, publicScopes []string, scopeFunc ScopeFunc) *Authenticator {
+	keys := make([][]byte, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		if apiKey == "" {
+			continue
+		}
+		keys = append(keys, []byte(apiKey))
+	}
+
+	scopes := make(map[string]struct{}, len(publicScopes))
+	for _, scope := range publicScopes {
+		scopes[scope] = struct{}{}
+	}
+
+	return &Authenticator{
+		apiKeys:      keys,
+		jwtSecret:    []byte(jwtSecret),
+		publicScopes: scopes,
+		scopeFunc:    scopeFunc,
+	}
+}
+
+func (a *Authenticator) isPublic(scope string) bool {
+	_, public := a.publicScopes[scope]
+
+	return public
+}
+
+// protectedScopes returns the given scopes that are not public.
+func (a *Authenticator) protectedScopes(scopes []string) []string {
+	protected := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !a.isPublic(scope) {
+			protected = append(protected, scope)
+		}
+	}
+
+	return protected
+}
+
+// missingScope returns the first of the given scopes that is not granted by the claims.
+func missingScope(claims *Claims, scopes []string) string {
+	granted := make(map[string]struct{}, len(claims.Scopes))
+	for _, grantedScope := range claims.Scopes {
+		if grantedScope == ScopeAll {
+			return ""
+		}
+		granted[grantedScope] = struct{}{}
+	}
+
+	for _, scope := range scopes {
+		if _, ok := granted[scope]; !ok {
+			return scope
+		}
+	}
+
+	return ""
+}
+
+func (a *Authenticator) isValidAPIKey(apiKey string) bool {
+	for _, key := range a.apiKeys {
+		if subtle.ConstantTimeCompare(key, []byte(apiKey)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseToken verifies the given JWT bearer token and returns its claims.
+func (a *Authenticator) parseToken(tokenString string) (*Claims, error) {
+	if len(a.jwtSecret) == 0 {
+		return nil, fmt.Errorf("bearer tokens are not accepted")
+	}
+
+	claims := &Claims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return a.jwtSecret, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// Authorize checks the given static API key or "Authorization" header against the given permission scopes.
+// It returns ErrUnauthorized if the credentials are missing or invalid and ErrForbidden if a scope is not granted.
+func (a *Authenticator) Authorize(apiKey string, authorization string, scopes []string) error {
+	scopes = a.protectedScopes(scopes)
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	if apiKey != "" {
+		if !a.isValidAPIKey(apiKey) {
+			return fmt.Errorf("%w: invalid API key", ErrUnauthorized)
+		}
+
+		return nil
+	}
+
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return fmt.Errorf("%w: missing credentials", ErrUnauthorized)
+	}
+
+	claims, err := a.parseToken(strings.TrimPrefix(authorization, bearerPrefix))
+	if err != nil {
+		return fmt.Errorf("%w: invalid bearer token: %s", ErrUnauthorized, err)
+	}
+
+	if scope := missingScope(claims, scopes); scope != "" {
+		return fmt.Errorf("%w: missing permission scope: %s", ErrForbidden, scope)
+	}
+
+	return nil
+}
+
+// Middleware returns an echo middleware that rejects requests without permission for the requested route.
+func (a *Authenticator) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := a.Authorize(c.Request().Header.Get(HeaderAPIKey), c.Request().Header.Get(echo.HeaderAuthorization), a.scopeFunc(c)); err != nil {
+				if errors.Is(err, ErrForbidden) {
+					return echo.NewHTTPError(http.StatusForbidden, err.Error())
+				}
+
+				return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func signedToken(t *testing.T, secret string, scopes []string, expiresAt time.Time) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{
+		StandardClaims: jwt.StandardClaims{ExpiresAt: expiresAt.Unix()},
+		Scopes:         scopes,
+	})
+
+	tokenString, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tokenString
+}
+
+func TestAuthenticatorAuthorize(t *testing.T) {
+	const secret = "secret"
+
+	a := New([]string{"key"}, secret, []string{"info"}, nil)
+	validUntil := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		apiKey        string
+		authorization string
+		scopes        []string
+		wantErr       error
+	}{
+		{
+			name:   "public scope",
+			scopes: []string{"info"},
+		},
+		{
+			name: "no scope",
+		},
+		{
+			name:    "empty scope is not public",
+			scopes:  []string{""},
+			wantErr: ErrUnauthorized,
+		},
+		{
+			name:    "missing credentials",
+			scopes:  []string{"ledgerState"},
+			wantErr: ErrUnauthorized,
+		},
+		{
+			name:   "valid api key",
+			apiKey: "key",
+			scopes: []string{"ledgerState"},
+		},
+		{
+			name:    "invalid api key",
+			apiKey:  "other",
+			scopes:  []string{"ledgerState"},
+			wantErr: ErrUnauthorized,
+		},
+		{
+			name:          "token with scope",
+			authorization: bearerPrefix + signedToken(t, secret, []string{"ledgerState"}, validUntil),
+			scopes:        []string{"info", "ledgerState"},
+		},
+		{
+			name:          "token with all scopes",
+			authorization: bearerPrefix + signedToken(t, secret, []string{ScopeAll}, validUntil),
+			scopes:        []string{"ledgerState", "transactions"},
+		},
+		{
+			name:          "token without scope",
+			authorization: bearerPrefix + signedToken(t, secret, []string{"transactions"}, validUntil),
+			scopes:        []string{"ledgerState"},
+			wantErr:       ErrForbidden,
+		},
+		{
+			name:          "expired token",
+			authorization: bearerPrefix + signedToken(t, secret, []string{ScopeAll}, time.Now().Add(-time.Hour)),
+			scopes:        []string{"ledgerState"},
+			wantErr:       ErrUnauthorized,
+		},
+		{
+			name:          "token with other secret",
+			authorization: bearerPrefix + signedToken(t, "other", []string{ScopeAll}, validUntil),
+			scopes:        []string{"ledgerState"},
+			wantErr:       ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := a.Authorize(tt.apiKey, tt.authorization, tt.scopes); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package coalesce
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// KeyFunc returns the key under which identical requests are coalesced.
+// An empty key means the request is not coalesced.
+type KeyFunc func(c echo.Context) string
+
+// result is the serialized response of a request.
+type result struct {
+	status      int
+	contentType string
+	body        []byte
+}
+
+// call is a request that is currently processed.
+type call struct {
+	done chan struct{}
+	// result is nil if the response can't be shared with the waiting requests.
+	result *result
+}
+
+// responseRecorder passes the response through to the original writer and keeps a copy of the body
+// up to the given maximum size.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	maxBodySize int
+	// overflowed is true if the body exceeded the maximum size and was discarded.
+	overflowed bool
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.status = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.overflowed {
+		if r.body.Len()+len(b) > r.maxBodySize {
+			// large responses are not kept in memory, the waiting requests process the request on their own
+			r.overflowed = true
+			r.body = bytes.Buffer{}
+		} else {
+			r.body.Write(b)
+		}
+	}
+
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Coalescer lets identical concurrent requests wait for the first one and share its response,
+// so the result is only computed once.
+type Coalescer struct {
+	keyFunc         KeyFunc
+	maxResponseSize int
+
+	callsLock sync.Mutex
+	calls     map[string]*call
+
+	coalescedRequests prometheus.Counter
+}
+
+// New creates a new Coalescer.
+// Responses that are larger than the given maximum size are not shared.
+func New(keyFunc KeyFunc, maxResponseSize int) *Coalescer {
+	return &Coalescer{
+		keyFunc:         keyFunc,
+		maxResponseSize: maxResponseSize,
+		calls:           make(map[string]*call),
+		coalescedRequests: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: "iota",
+				Subsystem: "restapi",
+				Name:      "coalesced_requests_total",
+				Help:      "The number of requests that were answered with the response of an identical concurrent request.",
+			},
+		),
+	}
+}
+
+// Collectors returns the prometheus collectors of the Coalescer.
+func (co *Coalescer) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{co.coalescedRequests}
+}
+
+// Middleware returns an echo middleware that coalesces identical concurrent requests.
+func (co *Coalescer) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := co.keyFunc(c)
+			if key == "" {
+				return next(c)
+			}
+
+			for {
+				co.callsLock.Lock()
+				runningCall, exists := co.calls[key]
+				if !exists {
+					newCall := &call{done: make(chan struct{})}
+					co.calls[key] = newCall
+					co.callsLock.Unlock()
+
+					return co.execute(c, next, key, newCall)
+				}
+				co.callsLock.Unlock()
+
+				select {
+				case <-runningCall.done:
+				case <-c.Request().Context().Done():
+					return c.Request().Context().Err()
+				}
+
+				if runningCall.result == nil {
+					// the response of the running call can't be shared, try again
+					continue
+				}
+
+				co.coalescedRequests.Inc()
+
+				return c.Blob(runningCall.result.status, runningCall.result.contentType, runningCall.result.body)
+			}
+		}
+	}
+}
+
+// execute processes the request and shares the response with the requests waiting for the call.
+func (co *Coalescer) execute(c echo.Context, next echo.HandlerFunc, key string, runningCall *call) error {
+	recorder := &responseRecorder{ResponseWriter: c.Response().Writer, status: http.StatusOK, maxBodySize: co.maxResponseSize}
+	c.Response().Writer = recorder
+
+	defer func() {
+		c.Response().Writer = recorder.ResponseWriter
+
+		co.callsLock.Lock()
+		delete(co.calls, key)
+		co.callsLock.Unlock()
+
+		close(runningCall.done)
+	}()
+
+	err := next(c)
+
+	// errors are handled later by the error handler of echo, the computation of canceled requests
+	// was aborted and too large responses were discarded, so the waiting requests need to process the request on their own.
+	if err == nil && c.Response().Committed && !recorder.overflowed && c.Request().Context().Err() == nil {
+		runningCall.result = &result{
+			status:      recorder.status,
+			contentType: c.Response().Header().Get(echo.HeaderContentType),
+			body:        recorder.body.Bytes(),
+		}
+	}
+
+	return err
+}
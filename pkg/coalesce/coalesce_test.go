@@ -0,0 +1,110 @@
+package coalesce
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/atomic"
+)
+
+func TestCoalescerMiddleware(t *testing.T) {
+	tests := []struct {
+		name            string
+		key             string
+		body            string
+		maxResponseSize int
+		wantExecutions  int32
+	}{
+		{
+			name:            "shared response",
+			key:             "key",
+			body:            "response",
+			maxResponseSize: 100,
+			wantExecutions:  1,
+		},
+		{
+			name:            "not coalesced",
+			key:             "",
+			body:            "response",
+			maxResponseSize: 100,
+			wantExecutions:  3,
+		},
+		{
+			name:            "response too large to share",
+			key:             "key",
+			body:            strings.Repeat("x", 101),
+			maxResponseSize: 100,
+			wantExecutions:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const requests = 3
+
+			co := New(func(c echo.Context) string { return tt.key }, tt.maxResponseSize)
+
+			executions := atomic.NewInt32(0)
+			release := make(chan struct{})
+
+			e := echo.New()
+			e.Use(co.Middleware())
+			e.GET("/", func(c echo.Context) error {
+				if executions.Inc() == 1 {
+					// the first call waits until the other requests are waiting for it
+					<-release
+				}
+
+				return c.String(http.StatusOK, tt.body)
+			})
+
+			var wg sync.WaitGroup
+			recorders := make([]*httptest.ResponseRecorder, requests)
+			for i := range recorders {
+				recorders[i] = httptest.NewRecorder()
+
+				wg.Add(1)
+				go func(rec *httptest.ResponseRecorder) {
+					defer wg.Done()
+					e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+				}(recorders[i])
+
+				if i == 0 {
+					waitFor(t, func() bool { return executions.Load() == 1 })
+				}
+			}
+
+			// give the other requests time to start waiting for the first call
+			time.Sleep(20 * time.Millisecond)
+			close(release)
+			wg.Wait()
+
+			if got := executions.Load(); got != tt.wantExecutions {
+				t.Fatalf("got %d executions, want %d", got, tt.wantExecutions)
+			}
+
+			for i, rec := range recorders {
+				if rec.Code != http.StatusOK || rec.Body.String() != tt.body {
+					t.Fatalf("request %d: got status %d and body %q", i, rec.Code, rec.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,156 @@
+// Package curl implements the Curl sponge function with a configurable amount of rounds.
+// iota.go only provides Curl-P-81, but the coordinator of the legacy network may also use Curl-P-27.
+package curl
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/iota.go/consts"
+	sponge "github.com/iotaledger/iota.go/signing/utils"
+	"github.com/iotaledger/iota.go/trinary"
+)
+
+const (
+	// StateSize is the size of the state of the sponge in trits.
+	StateSize = consts.HashTrinarySize * 3
+
+	// NumRoundsP27 is the amount of rounds of Curl-P-27.
+	NumRoundsP27 = 27
+	// NumRoundsP81 is the amount of rounds of Curl-P-81.
+	NumRoundsP81 = 81
+)
+
+// truthTable is the substitution box of the Curl transformation.
+var truthTable = [11]int8{1, 0, -1, 2, 1, -1, 0, 2, -1, 1, 0}
+
+// Curl is a sponge function with an internal state of StateSize trits.
+type Curl struct {
+	rounds  int
+	state   trinary.Trits
+	scratch trinary.Trits
+}
+
+// New returns a new Curl sponge function with the given amount of rounds.
+func New(rounds int) *Curl {
+	return &Curl{
+		rounds:  rounds,
+		state:   make(trinary.Trits, StateSize),
+		scratch: make(trinary.Trits, StateSize),
+	}
+}
+
+// NewCurlP27 returns a new Curl-P-27.
+func NewCurlP27() sponge.SpongeFunction {
+	return New(NumRoundsP27)
+}
+
+// NewCurlP81 returns a new Curl-P-81.
+func NewCurlP81() sponge.SpongeFunction {
+	return New(NumRoundsP81)
+}
+
+// transform applies the rounds of the Curl transformation to the state.
+func (c *Curl) transform() {
+	for round := 0; round < c.rounds; round++ {
+		copy(c.scratch, c.state)
+
+		index := 0
+		for i := 0; i < StateSize; i++ {
+			prevIndex := index
+			if index < 365 {
+				index += 364
+			} else {
+				index -= 365
+			}
+			c.state[i] = truthTable[c.scratch[prevIndex]+(c.scratch[index]<<2)+5]
+		}
+	}
+}
+
+// Absorb fills the internal state of the sponge with the given trits.
+// The length of the trits has to be a multiple of HashTrinarySize.
+func (c *Curl) Absorb(in trinary.Trits) error {
+	if len(in) == 0 || len(in)%consts.HashTrinarySize != 0 {
+		return errors.Wrap(consts.ErrInvalidTritsLength, "trits slice length must be a multiple of 243")
+	}
+
+	for ; len(in) >= consts.HashTrinarySize; in = in[consts.HashTrinarySize:] {
+		copy(c.state, in[:consts.HashTrinarySize])
+		c.transform()
+	}
+
+	return nil
+}
+
+// AbsorbTrytes fills the internal state of the sponge with the given trytes.
+func (c *Curl) AbsorbTrytes(in trinary.Trytes) error {
+	trits, err := trinary.TrytesToTrits(in)
+	if err != nil {
+		return err
+	}
+
+	return c.Absorb(trits)
+}
+
+// MustAbsorbTrytes fills the internal state of the sponge with the given trytes. It panics if the trytes are not valid.
+func (c *Curl) MustAbsorbTrytes(in trinary.Trytes) {
+	if err := c.AbsorbTrytes(in); err != nil {
+		panic(err)
+	}
+}
+
+// Squeeze squeezes out trits of the given length.
+// The length has to be a multiple of HashTrinarySize.
+func (c *Curl) Squeeze(length int) (trinary.Trits, error) {
+	if length%consts.HashTrinarySize != 0 {
+		return nil, consts.ErrInvalidSqueezeLength
+	}
+
+	out := make(trinary.Trits, length)
+	for hash := out; len(hash) >= consts.HashTrinarySize; hash = hash[consts.HashTrinarySize:] {
+		copy(hash, c.state[:consts.HashTrinarySize])
+		c.transform()
+	}
+
+	return out, nil
+}
+
+// MustSqueeze squeezes out trits of the given length. It panics if the length is not valid.
+func (c *Curl) MustSqueeze(length int) trinary.Trits {
+	out, err := c.Squeeze(length)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+// SqueezeTrytes squeezes out trytes of the given trit length.
+func (c *Curl) SqueezeTrytes(length int) (trinary.Trytes, error) {
+	trits, err := c.Squeeze(length)
+	if err != nil {
+		return "", err
+	}
+
+	return trinary.MustTritsToTrytes(trits), nil
+}
+
+// MustSqueezeTrytes squeezes out trytes of the given trit length. It panics if the length is not valid.
+func (c *Curl) MustSqueezeTrytes(length int) trinary.Trytes {
+	return trinary.MustTritsToTrytes(c.MustSqueeze(length))
+}
+
+// Reset sets the state of the sponge to zero.
+func (c *Curl) Reset() {
+	for i := range c.state {
+		c.state[i] = 0
+	}
+}
+
+// Clone returns a deep copy of the sponge.
+func (c *Curl) Clone() sponge.SpongeFunction {
+	clone := New(c.rounds)
+	copy(clone.state, c.state)
+
+	return clone
+}
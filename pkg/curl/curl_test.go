@@ -0,0 +1,133 @@
+package curl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/trinary"
+)
+
+// curlP81Vectors are known answers of Curl-P-81, taken from the golden test vectors of iota.go.
+var curlP81Vectors = []struct {
+	name string
+	in   trinary.Trytes
+	hash trinary.Hash
+}{
+	{
+		name: "single chunk #1",
+		in:   "QZELVPOZTGSBCMEIZWZBGFSRPQNSMBREV9QD9JINWPNHHVCIFFGMHUH99OLWPXUZ9AWKJVYEC9JDTKRZO",
+		hash: "9MMGDFTUNMXVFRWTMVYWHKIUMJRWZPYVYDYHNATZWSLWPUSULDZVSJJXQPKXENXJFLTSEEMBJIWZLLXBX",
+	},
+	{
+		name: "single chunk #2",
+		in:   "ZYMHMWWBGGZYFLBGVBIUIRBWBIZOJEVOBUSIVUEIHI9S9EHIVZPZWGHG9THDDPBNIXDLCPYIAVQELZEFD",
+		hash: "KMNWODCXRXYVGKSTRTAOV9SQDHIVKACSHGQQINUNVFITWFHOCEWEZDVVUBDVJJLTESKTOUAXBSBICGL9K",
+	},
+	{
+		name: "single chunk #3",
+		in:   "IBCOIDJ9TCZZSDRD9XCILKQIFOZWQPDEGPXSBOTZPZROFJVCVOECEDNBOCMJIDWQSUKDVIIQDEU9CNDVH",
+		hash: "KNLJDCZEXQZOSUIZWTJIQPEUOKERQXUNAEHRGQHIJUUSIRKRJYRFUHG9KKCPTAZTHPC9PEHIIUFWYKBFV",
+	},
+	{
+		name: "padded trytes",
+		in:   trinary.MustPad("NOPQRSTUVWXYZ9ABSDEFGHIJKLM", consts.HashTrytesSize),
+		hash: "GWFZSXPZPAFSVPEGEIVWOTD9MY9KVP9HYVCIWSJEITEGVOVGQGV99RONTWDXOPUBIQPIWXK9L9OHZYFUB",
+	},
+	{
+		name: "transaction",
+		in:   strings.Repeat("ABC", consts.TransactionTrytesSize/3),
+		hash: "UHZVKZCGDIPNGFNPBNFZGIM9GAKYLCPTHTRFRXMNDJLZNXSGRPREFWTBKZWVTKV9BISPXEECVIXFJERAC",
+	},
+}
+
+func TestCurlP81(t *testing.T) {
+	for _, tt := range curlP81Vectors {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCurlP81()
+			if err := c.AbsorbTrytes(tt.in); err != nil {
+				t.Fatal(err)
+			}
+
+			hash, err := c.SqueezeTrytes(consts.HashTrinarySize)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if hash != tt.hash {
+				t.Fatalf("got hash %s, want %s", hash, tt.hash)
+			}
+		})
+	}
+}
+
+// TestCurlP27 derives the known answers of Curl-P-27 from the ones of Curl-P-81.
+// Every squeeze applies the transformation to the state, so the third hash that is squeezed from Curl-P-27
+// is taken from the state after 81 rounds, which is the first hash of Curl-P-81 if a single chunk was absorbed.
+func TestCurlP27(t *testing.T) {
+	for _, tt := range curlP81Vectors {
+		if len(tt.in) != consts.HashTrytesSize {
+			continue
+		}
+
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCurlP27()
+			if err := c.AbsorbTrytes(tt.in); err != nil {
+				t.Fatal(err)
+			}
+
+			hashes, err := c.SqueezeTrytes(3 * consts.HashTrinarySize)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if hash := hashes[:consts.HashTrytesSize]; hash == tt.hash {
+				t.Fatalf("got the hash of Curl-P-81 after 27 rounds: %s", hash)
+			}
+
+			if hash := hashes[2*consts.HashTrytesSize:]; hash != tt.hash {
+				t.Fatalf("got hash %s after 81 rounds, want %s", hash, tt.hash)
+			}
+		})
+	}
+}
+
+func TestCurlInvalidLength(t *testing.T) {
+	tests := []struct {
+		name           string
+		absorbLength   int
+		squeezeLength  int
+		wantAbsorbErr  bool
+		wantSqueezeErr bool
+	}{
+		{name: "empty absorb", absorbLength: 0, squeezeLength: consts.HashTrinarySize, wantAbsorbErr: true},
+		{name: "partial absorb", absorbLength: consts.HashTrinarySize + 1, squeezeLength: consts.HashTrinarySize, wantAbsorbErr: true},
+		{name: "partial squeeze", absorbLength: consts.HashTrinarySize, squeezeLength: consts.HashTrinarySize - 1, wantSqueezeErr: true},
+		{name: "valid lengths", absorbLength: 2 * consts.HashTrinarySize, squeezeLength: 2 * consts.HashTrinarySize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCurlP27()
+			if err := c.Absorb(make(trinary.Trits, tt.absorbLength)); (err != nil) != tt.wantAbsorbErr {
+				t.Fatalf("got absorb error %v, want error: %t", err, tt.wantAbsorbErr)
+			}
+
+			if _, err := c.Squeeze(tt.squeezeLength); (err != nil) != tt.wantSqueezeErr {
+				t.Fatalf("got squeeze error %v, want error: %t", err, tt.wantSqueezeErr)
+			}
+		})
+	}
+}
+
+func TestCurlClone(t *testing.T) {
+	c := NewCurlP27()
+	if err := c.AbsorbTrytes(curlP81Vectors[0].in); err != nil {
+		t.Fatal(err)
+	}
+
+	clone := c.Clone()
+	if c.MustSqueezeTrytes(consts.HashTrinarySize) != clone.MustSqueezeTrytes(consts.HashTrinarySize) {
+		t.Fatal("clone squeezed a different hash")
+	}
+}
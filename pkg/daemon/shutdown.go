@@ -1,9 +1,11 @@
 package daemon
 
 const (
-	PriorityDisconnectINX = iota // no dependencies
+	PriorityStopTracing = iota // no dependencies
+	PriorityDisconnectINX
 	PriorityStopDatabase
 	PriorityStopDatabaseAPI
+	PriorityStopGRPCAPI
 	PriorityStopDatabaseAPIINX
 	PriorityStopPrometheus
 )
@@ -1,6 +1,8 @@
 package database
 
 import (
+	"context"
+
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
@@ -24,7 +26,17 @@ func databaseKeyPrefixForAddressTransaction(address hornet.Hash, txHash hornet.H
 	return append(result, txHash...)
 }
 
+// GetTransactionHashesForAddress returns the hashes of the transactions of the given address.
 func (db *Database) GetTransactionHashesForAddress(address hornet.Hash, valueOnly bool, forceRelease bool, maxFind ...int) hornet.Hashes {
+	return db.GetTransactionHashesForAddressContext(context.Background(), address, valueOnly, forceRelease, maxFind...)
+}
+
+// GetTransactionHashesForAddressContext is like GetTransactionHashesForAddress, but traces the call in the span of the given context.
+func (db *Database) GetTransactionHashesForAddressContext(ctx context.Context, address hornet.Hash, valueOnly bool, forceRelease bool, maxFind ...int) hornet.Hashes {
+	var txHashes hornet.Hashes
+
+	_, done := db.TrackMethod(ctx, "GetTransactionHashesForAddress")
+	defer func() { done(len(txHashes)) }()
 
 	searchPrefix := databaseKeyPrefixForAddress(address)
 	if valueOnly {
@@ -32,8 +44,6 @@ func (db *Database) GetTransactionHashesForAddress(address hornet.Hash, valueOnl
 		searchPrefix = append(searchPrefix, isValueByte)
 	}
 
-	var txHashes hornet.Hashes
-
 	i := 0
 	_ = db.addressesStore.IterateKeys(searchPrefix, func(key []byte) bool {
 		i++
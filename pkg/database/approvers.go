@@ -1,13 +1,24 @@
 package database
 
 import (
+	"context"
+
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
 
+// GetApproverHashes returns the hashes of the transactions that approve the given transaction.
 func (db *Database) GetApproverHashes(txHash hornet.Hash, maxFind ...int) hornet.Hashes {
+	return db.GetApproverHashesContext(context.Background(), txHash, maxFind...)
+}
+
+// GetApproverHashesContext is like GetApproverHashes, but traces the call in the span of the given context.
+func (db *Database) GetApproverHashesContext(ctx context.Context, txHash hornet.Hash, maxFind ...int) hornet.Hashes {
 	var approverHashes hornet.Hashes
 
+	_, done := db.trackLookup(ctx, "GetApproverHashes")
+	defer func() { done(len(approverHashes)) }()
+
 	i := 0
 	_ = db.approversStore.IterateKeys(txHash, func(key []byte) bool {
 		i++
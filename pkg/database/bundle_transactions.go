@@ -1,6 +1,8 @@
 package database
 
 import (
+	"context"
+
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
 
@@ -12,9 +14,25 @@ func databaseKeyPrefixForBundleHash(bundleHash hornet.Hash) []byte {
 	return bundleHash
 }
 
+func databaseKeyPrefixForBundleTailTransactions(bundleHash hornet.Hash) []byte {
+	key := make([]byte, 0, 50)
+	key = append(key, databaseKeyPrefixForBundleHash(bundleHash)...)
+
+	return append(key, BundleTxIsTail)
+}
+
+// GetBundleTransactionHashes returns the hashes of all transactions of the given bundle hash.
 func (db *Database) GetBundleTransactionHashes(bundleHash hornet.Hash, forceRelease bool, maxFind ...int) hornet.Hashes {
+	return db.GetBundleTransactionHashesContext(context.Background(), bundleHash, forceRelease, maxFind...)
+}
+
+// GetBundleTransactionHashesContext is like GetBundleTransactionHashes, but traces the call in the span of the given context.
+func (db *Database) GetBundleTransactionHashesContext(ctx context.Context, bundleHash hornet.Hash, forceRelease bool, maxFind ...int) hornet.Hashes {
 	var bundleTransactionHashes hornet.Hashes
 
+	_, done := db.TrackMethod(ctx, "GetBundleTransactionHashes")
+	defer func() { done(len(bundleTransactionHashes)) }()
+
 	/*
 		49 bytes					bundleHash
 		1 byte  	   				isTail
@@ -35,3 +53,31 @@ func (db *Database) GetBundleTransactionHashes(bundleHash hornet.Hash, forceRele
 
 	return bundleTransactionHashes
 }
+
+// GetBundleTailTransactionHashes returns the hashes of all tail transactions of the given bundle hash.
+// Every tail transaction belongs to a different attachment of the bundle.
+func (db *Database) GetBundleTailTransactionHashes(bundleHash hornet.Hash, maxFind ...int) hornet.Hashes {
+	return db.GetBundleTailTransactionHashesContext(context.Background(), bundleHash, maxFind...)
+}
+
+// GetBundleTailTransactionHashesContext is like GetBundleTailTransactionHashes, but traces the call in the span of the given context.
+func (db *Database) GetBundleTailTransactionHashesContext(ctx context.Context, bundleHash hornet.Hash, maxFind ...int) hornet.Hashes {
+	var tailTransactionHashes hornet.Hashes
+
+	_, done := db.TrackMethod(ctx, "GetBundleTailTransactionHashes")
+	defer func() { done(len(tailTransactionHashes)) }()
+
+	i := 0
+	_ = db.bundleTransactionsStore.IterateKeys(databaseKeyPrefixForBundleTailTransactions(bundleHash), func(key []byte) bool {
+		i++
+		if (len(maxFind) > 0) && (i > maxFind[0]) {
+			return false
+		}
+
+		tailTransactionHashes = append(tailTransactionHashes, key[50:99])
+
+		return true
+	})
+
+	return tailTransactionHashes
+}
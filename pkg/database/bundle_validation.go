@@ -0,0 +1,266 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/kerl"
+	iotagomath "github.com/iotaledger/iota.go/math"
+	"github.com/iotaledger/iota.go/signing"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+)
+
+const (
+	// BundleCheckStructure checks that the transactions form a chain with consecutive indexes,
+	// the same last index and the same bundle hash.
+	BundleCheckStructure = "structure"
+	// BundleCheckTransactionHash checks that the hashes of the transactions match their trytes.
+	BundleCheckTransactionHash = "transactionHash"
+	// BundleCheckValue checks that the values and the balance mutations don't exceed the total supply.
+	BundleCheckValue = "value"
+	// BundleCheckValueSum checks that the values of all transactions sum up to zero.
+	BundleCheckValueSum = "valueSum"
+	// BundleCheckBundleHash checks that the bundle hash matches the essence of the transactions.
+	BundleCheckBundleHash = "bundleHash"
+	// BundleCheckSignature checks the signatures of all input transactions.
+	BundleCheckSignature = "signature"
+)
+
+// BundleValidationFailure is a check that failed during the re-validation of a bundle.
+type BundleValidationFailure struct {
+	// Check is the name of the failed check.
+	Check string
+	// Error describes why the check failed.
+	Error string
+}
+
+// BundleValidation is the result of the re-validation of a bundle from its raw transactions.
+type BundleValidation struct {
+	TailTxHash hornet.Hash
+	// Transactions are the transactions of the bundle in the order of their indexes.
+	// It only contains the found transactions if the bundle is incomplete.
+	Transactions []*Transaction
+	// BundleHash is the bundle hash of the tail transaction.
+	BundleHash trinary.Hash
+	// ComputedBundleHash is the bundle hash computed from the essence of the transactions.
+	// It is empty if the bundle is incomplete.
+	ComputedBundleHash trinary.Hash
+	// Stored is true if the bundle was stored by the node.
+	Stored bool
+	// StoredValid is the validity the node stored for the bundle.
+	StoredValid bool
+	Failures    []*BundleValidationFailure
+}
+
+// Valid returns whether all checks passed.
+func (v *BundleValidation) Valid() bool {
+	return len(v.Failures) == 0
+}
+
+func (v *BundleValidation) fail(check string, format string, args ...interface{}) {
+	v.Failures = append(v.Failures, &BundleValidationFailure{
+		Check: check,
+		Error: fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateBundleOrNil re-validates the bundle of the given tail transaction from the raw transactions.
+// It returns nil if the transaction doesn't exist.
+// Unlike the stored validity of the bundle, all checks are done on the transactions that are reached
+// by walking the trunk of the tail transaction, and every failed check is reported.
+func (db *Database) ValidateBundleOrNil(ctx context.Context, tailTxHash hornet.Hash) *BundleValidation {
+	_, done := db.TrackMethod(ctx, "ValidateBundleOrNil")
+
+	validation, keysIterated := db.validateBundleOrNil(tailTxHash)
+	done(keysIterated)
+
+	return validation
+}
+
+func (db *Database) validateBundleOrNil(tailTxHash hornet.Hash) (*BundleValidation, int) {
+	tail := db.getTransactionOrNil(tailTxHash)
+	if tail == nil {
+		return nil, 1
+	}
+	keysIterated := 1
+
+	validation := &BundleValidation{
+		TailTxHash:   tailTxHash,
+		Transactions: []*Transaction{tail},
+		BundleHash:   tail.Tx.Bundle,
+	}
+
+	if bundle := db.getBundleOrNil(tailTxHash); bundle != nil {
+		validation.Stored = true
+		validation.StoredValid = bundle.IsValid()
+	}
+	keysIterated++
+
+	validation.validate(func(txHash hornet.Hash) *Transaction {
+		keysIterated++
+
+		return db.getTransactionOrNil(txHash)
+	})
+
+	return validation, keysIterated
+}
+
+// validate runs all checks on the bundle of the tail transaction.
+func (v *BundleValidation) validate(loadTransaction func(txHash hornet.Hash) *Transaction) {
+	complete := v.validateStructure(loadTransaction)
+	v.validateTransactionHashes()
+	v.validateValues()
+
+	if complete {
+		v.validateBundleHash()
+	}
+	v.validateSignatures()
+}
+
+// validateStructure walks the trunk of the tail transaction and collects the transactions of the bundle.
+// It returns false if the bundle is incomplete.
+func (v *BundleValidation) validateStructure(loadTransaction func(txHash hornet.Hash) *Transaction) bool {
+	tail := v.Transactions[0]
+	if tail.Tx.CurrentIndex != 0 {
+		v.fail(BundleCheckStructure, "transaction is not a tail transaction, current index is %d", tail.Tx.CurrentIndex)
+
+		return false
+	}
+
+	for tx := tail; tx.Tx.CurrentIndex < tail.Tx.LastIndex; {
+		next := loadTransaction(tx.GetTrunkHash())
+		if next == nil {
+			v.fail(BundleCheckStructure, "transaction at index %d not found: %s", tx.Tx.CurrentIndex+1, tx.Tx.TrunkTransaction)
+
+			return false
+		}
+
+		if next.Tx.Bundle != tail.Tx.Bundle {
+			v.fail(BundleCheckStructure, "transaction at index %d has a different bundle hash: %s", tx.Tx.CurrentIndex+1, next.Tx.Bundle)
+
+			return false
+		}
+
+		if next.Tx.CurrentIndex != tx.Tx.CurrentIndex+1 {
+			v.fail(BundleCheckStructure, "transaction %s has current index %d, expected %d", next.Tx.Hash, next.Tx.CurrentIndex, tx.Tx.CurrentIndex+1)
+
+			return false
+		}
+
+		if next.Tx.LastIndex != tail.Tx.LastIndex {
+			v.fail(BundleCheckStructure, "transaction at index %d has last index %d, expected %d", next.Tx.CurrentIndex, next.Tx.LastIndex, tail.Tx.LastIndex)
+		}
+
+		v.Transactions = append(v.Transactions, next)
+		tx = next
+	}
+
+	return true
+}
+
+// validateTransactionHashes recomputes the hashes of the transactions from their trytes.
+func (v *BundleValidation) validateTransactionHashes() {
+	for _, tx := range v.Transactions {
+		if computedHash := transaction.TransactionHash(tx.Tx); computedHash != tx.Tx.Hash {
+			v.fail(BundleCheckTransactionHash, "transaction at index %d has hash %s, but its trytes hash to %s", tx.Tx.CurrentIndex, tx.Tx.Hash, computedHash)
+		}
+	}
+}
+
+// validateValues checks that no value exceeds the total supply and that the values sum up to zero.
+func (v *BundleValidation) validateValues() {
+	var totalSum int64
+	changes := make(map[trinary.Hash]int64)
+	for _, tx := range v.Transactions {
+		if iotagomath.AbsInt64(tx.Tx.Value) > consts.TotalSupply {
+			v.fail(BundleCheckValue, "value of transaction at index %d exceeds the total supply: %d", tx.Tx.CurrentIndex, tx.Tx.Value)
+
+			return
+		}
+
+		totalSum += tx.Tx.Value
+		changes[tx.Tx.Address] += tx.Tx.Value
+		if iotagomath.AbsInt64(changes[tx.Tx.Address]) > consts.TotalSupply {
+			v.fail(BundleCheckValue, "balance mutation of address %s exceeds the total supply: %d", tx.Tx.Address, changes[tx.Tx.Address])
+
+			return
+		}
+	}
+
+	if iotagomath.AbsInt64(totalSum) > consts.TotalSupply {
+		v.fail(BundleCheckValue, "sum of the values exceeds the total supply: %d", totalSum)
+
+		return
+	}
+
+	if totalSum != 0 {
+		v.fail(BundleCheckValueSum, "values sum up to %d instead of 0", totalSum)
+	}
+}
+
+// validateBundleHash computes the bundle hash from the essence of the transactions.
+func (v *BundleValidation) validateBundleHash() {
+	k := kerl.NewKerl()
+	for _, tx := range v.Transactions {
+		txTrits, err := transaction.TransactionToTrits(tx.Tx)
+		if err != nil {
+			v.fail(BundleCheckBundleHash, "failed to convert transaction at index %d: %s", tx.Tx.CurrentIndex, err)
+
+			return
+		}
+
+		// the last trit of the address is set to zero for backward compatibility
+		essenceTrits := txTrits[consts.AddressTrinaryOffset:consts.BundleTrinaryOffset]
+		essenceTrits[consts.HashTrinarySize-1] = 0
+		if err := k.Absorb(essenceTrits); err != nil {
+			v.fail(BundleCheckBundleHash, "failed to absorb essence of transaction at index %d: %s", tx.Tx.CurrentIndex, err)
+
+			return
+		}
+	}
+
+	computedBundleHash, err := k.SqueezeTrytes(consts.HashTrinarySize)
+	if err != nil {
+		v.fail(BundleCheckBundleHash, "failed to compute bundle hash: %s", err)
+
+		return
+	}
+	v.ComputedBundleHash = computedBundleHash
+
+	if computedBundleHash != v.BundleHash {
+		v.fail(BundleCheckBundleHash, "bundle hash is %s, but the essence hashes to %s", v.BundleHash, computedBundleHash)
+	}
+}
+
+// validateSignatures validates the signature of every input transaction against the bundle hash of the tail.
+// Like in iota.go, the signature fragments are taken from the input and all following zero value transactions of the same address.
+func (v *BundleValidation) validateSignatures() {
+	for i, tx := range v.Transactions {
+		if tx.Tx.Value >= 0 {
+			continue
+		}
+
+		fragments := []trinary.Trytes{tx.Tx.SignatureMessageFragment}
+		for _, otherTx := range v.Transactions[i+1:] {
+			if otherTx.Tx.Value != 0 || otherTx.Tx.Address != tx.Tx.Address {
+				continue
+			}
+			fragments = append(fragments, otherTx.Tx.SignatureMessageFragment)
+		}
+
+		valid, err := signing.ValidateSignatures(tx.Tx.Address, fragments, v.BundleHash)
+		if err != nil {
+			v.fail(BundleCheckSignature, "failed to validate signature of input at index %d: %s", tx.Tx.CurrentIndex, err)
+
+			continue
+		}
+
+		if !valid {
+			v.fail(BundleCheckSignature, "invalid signature of input at index %d for address %s", tx.Tx.CurrentIndex, tx.Tx.Address)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package database
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/bundle"
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/kerl"
+	"github.com/iotaledger/iota.go/signing"
+	"github.com/iotaledger/iota.go/signing/key"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+)
+
+const testSeed = "SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9SEED9A"
+
+// newTestValueBundle returns a signed bundle that transfers 100 tokens from an input address of security level 2.
+func newTestValueBundle(t *testing.T) bundle.Bundle {
+	t.Helper()
+
+	subseed, err := signing.Subseed(testSeed, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := key.Sponge(subseed, consts.SecurityLevelMedium, kerl.NewKerl())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digests, err := signing.Digests(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addressTrits, err := signing.Address(digests)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bundle.Bundle{}
+	b = bundle.AddEntry(b, bundle.BundleEntry{Length: 1, Address: strings.Repeat("B", consts.HashTrytesSize), Value: 100, Timestamp: 1})
+	b = bundle.AddEntry(b, bundle.BundleEntry{Length: 2, Address: trinary.MustTritsToTrytes(addressTrits), Value: -100, Timestamp: 1})
+
+	b, err = bundle.Finalize(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	normalizedBundleHash := signing.NormalizedBundleHash(b[0].Bundle)
+	for i := 0; i < int(consts.SecurityLevelMedium); i++ {
+		fragment, err := signing.SignatureFragment(
+			normalizedBundleHash[i*consts.KeySegmentsPerFragment:(i+1)*consts.KeySegmentsPerFragment],
+			privateKey[i*consts.KeyFragmentLength:(i+1)*consts.KeyFragmentLength],
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b[1+i].SignatureMessageFragment = trinary.MustTritsToTrytes(fragment)
+	}
+
+	return b
+}
+
+// attachTestBundle computes the hashes of the transactions and links every transaction to the next one by its trunk.
+func attachTestBundle(b bundle.Bundle) map[string]*Transaction {
+	txs := make(map[string]*Transaction, len(b))
+
+	trunk := consts.NullHashTrytes
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i].TrunkTransaction = trunk
+		b[i].BranchTransaction = consts.NullHashTrytes
+		b[i].Nonce = strings.Repeat("9", consts.NonceTrinarySize/3)
+		b[i].Hash = transaction.TransactionHash(&b[i])
+		trunk = b[i].Hash
+
+		tx := b[i]
+		txs[tx.Hash] = &Transaction{Tx: &tx}
+	}
+
+	return txs
+}
+
+func TestBundleValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		// tamperBeforeAttach changes the bundle before the transaction hashes are computed.
+		tamperBeforeAttach func(b bundle.Bundle)
+		// tamperAfterAttach changes the attached transactions, so their hashes don't match anymore.
+		tamperAfterAttach func(txs map[string]*Transaction, b bundle.Bundle)
+		wantChecks        []string
+	}{
+		{
+			name: "valid bundle",
+		},
+		{
+			name: "values don't sum up to zero",
+			tamperBeforeAttach: func(b bundle.Bundle) {
+				b[0].Value = 101
+			},
+			wantChecks: []string{BundleCheckValueSum, BundleCheckBundleHash},
+		},
+		{
+			name: "value exceeds the total supply",
+			tamperBeforeAttach: func(b bundle.Bundle) {
+				b[0].Value = int64(consts.TotalSupply) + 1
+				b[1].Value = -int64(consts.TotalSupply) - 1
+			},
+			wantChecks: []string{BundleCheckValue, BundleCheckBundleHash},
+		},
+		{
+			name: "invalid signature",
+			tamperBeforeAttach: func(b bundle.Bundle) {
+				b[2].SignatureMessageFragment = b[1].SignatureMessageFragment
+			},
+			wantChecks: []string{BundleCheckSignature},
+		},
+		{
+			name: "transaction doesn't match its hash",
+			tamperAfterAttach: func(txs map[string]*Transaction, b bundle.Bundle) {
+				txs[b[0].Hash].Tx.Tag = strings.Repeat("A", consts.TagTrinarySize/3)
+			},
+			wantChecks: []string{BundleCheckTransactionHash},
+		},
+		{
+			name: "missing transaction",
+			tamperAfterAttach: func(txs map[string]*Transaction, b bundle.Bundle) {
+				delete(txs, b[2].Hash)
+			},
+			wantChecks: []string{BundleCheckStructure, BundleCheckSignature},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestValueBundle(t)
+			if tt.tamperBeforeAttach != nil {
+				tt.tamperBeforeAttach(b)
+			}
+
+			txs := attachTestBundle(b)
+			if tt.tamperAfterAttach != nil {
+				tt.tamperAfterAttach(txs, b)
+			}
+
+			validation := &BundleValidation{
+				TailTxHash:   hornet.HashFromHashTrytes(b[0].Hash),
+				Transactions: []*Transaction{txs[b[0].Hash]},
+				BundleHash:   b[0].Bundle,
+			}
+			validation.validate(func(txHash hornet.Hash) *Transaction {
+				return txs[txHash.Trytes()]
+			})
+
+			gotChecks := make([]string, 0, len(validation.Failures))
+			for _, failure := range validation.Failures {
+				gotChecks = append(gotChecks, failure.Check)
+			}
+
+			if strings.Join(gotChecks, ",") != strings.Join(tt.wantChecks, ",") {
+				t.Fatalf("got failed checks %v, want %v (%+v)", gotChecks, tt.wantChecks, validation.Failures)
+			}
+
+			if validation.Valid() != (len(tt.wantChecks) == 0) {
+				t.Fatalf("got valid %t, want %t", validation.Valid(), len(tt.wantChecks) == 0)
+			}
+		})
+	}
+}
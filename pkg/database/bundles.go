@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"log"
@@ -164,7 +165,7 @@ func (bundle *Bundle) GetMilestoneHash() hornet.Hash {
 }
 
 func (db *Database) loadBundleTxIfExistsOrPanic(txHash hornet.Hash, bundleHash hornet.Hash) *Transaction {
-	tx := db.GetTransactionOrNil(txHash)
+	tx := db.getTransactionOrNil(txHash)
 	if tx == nil {
 		log.Panicf("bundle %s has a reference to a non persisted transaction: %s", bundleHash.Trytes(), txHash.Trytes())
 	}
@@ -172,7 +173,23 @@ func (db *Database) loadBundleTxIfExistsOrPanic(txHash hornet.Hash, bundleHash h
 	return tx
 }
 
+// GetBundleOrNil returns the bundle of the given tail transaction or nil if it doesn't exist.
+// The transactions of the bundle are loaded on demand.
 func (db *Database) GetBundleOrNil(tailTxHash hornet.Hash) *Bundle {
+	return db.GetBundleOrNilContext(context.Background(), tailTxHash)
+}
+
+// GetBundleOrNilContext is like GetBundleOrNil, but traces the call in the span of the given context.
+func (db *Database) GetBundleOrNilContext(ctx context.Context, tailTxHash hornet.Hash) *Bundle {
+	_, done := db.trackLookup(ctx, "GetBundleOrNil")
+
+	bundle := db.getBundleOrNil(tailTxHash)
+	done(keysFound(bundle != nil))
+
+	return bundle
+}
+
+func (db *Database) getBundleOrNil(tailTxHash hornet.Hash) *Bundle {
 	key := databaseKeyForBundle(tailTxHash)
 
 	data, err := db.bundleStore.Get(key)
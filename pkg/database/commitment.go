@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/merkle"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+const (
+	// maxCachedLedgerStateCommitments is the amount of milestones whose ledger state commitment is kept in memory.
+	maxCachedLedgerStateCommitments = 4
+)
+
+// LedgerStateLeaf returns the data of the Merkle tree leaf of an address in the ledger state commitment.
+// It consists of the 81 address trytes followed by the balance as 8 byte big-endian integer.
+func LedgerStateLeaf(address trinary.Hash, balance uint64) []byte {
+	leaf := make([]byte, len(address)+8)
+	copy(leaf, address)
+	binary.BigEndian.PutUint64(leaf[len(address):], balance)
+
+	return leaf
+}
+
+// LedgerStateCommitment is a Merkle tree over the ledger state at a milestone.
+// The leaves are all addresses with a non-zero balance, ordered by their trytes.
+type LedgerStateCommitment struct {
+	MilestoneIndex milestone.Index
+	// Addresses are the addresses of the leaves in the order of the tree.
+	Addresses []trinary.Hash
+	// Balances are the balances of the leaves in the order of the tree.
+	Balances []uint64
+	Tree     *merkle.Tree
+}
+
+// newLedgerStateCommitment creates the commitment over the given balances.
+func newLedgerStateCommitment(milestoneIndex milestone.Index, balances map[string]uint64) *LedgerStateCommitment {
+	addresses := make([]trinary.Hash, 0, len(balances))
+	balancesByAddress := make(map[trinary.Hash]uint64, len(balances))
+	for address, balance := range balances {
+		if balance == 0 {
+			continue
+		}
+
+		addressTrytes := hornet.Hash(address).Trytes()
+		addresses = append(addresses, addressTrytes)
+		balancesByAddress[addressTrytes] = balance
+	}
+	sort.Strings(addresses)
+
+	commitment := &LedgerStateCommitment{
+		MilestoneIndex: milestoneIndex,
+		Addresses:      addresses,
+		Balances:       make([]uint64, 0, len(addresses)),
+	}
+
+	leaves := make([]merkle.Hash, 0, len(addresses))
+	for _, address := range addresses {
+		balance := balancesByAddress[address]
+		commitment.Balances = append(commitment.Balances, balance)
+		leaves = append(leaves, merkle.LeafHash(LedgerStateLeaf(address, balance)))
+	}
+	commitment.Tree = merkle.New(leaves)
+
+	return commitment
+}
+
+// LeafIndex returns the index of the leaf of the given 81 tryte address.
+// The second return value is false if the address has no balance at the milestone.
+func (c *LedgerStateCommitment) LeafIndex(address trinary.Hash) (int, bool) {
+	index := sort.SearchStrings(c.Addresses, address)
+	if index == len(c.Addresses) || c.Addresses[index] != address {
+		return 0, false
+	}
+
+	return index, true
+}
+
+// GetLedgerStateCommitment returns the Merkle tree over the ledger state at the given milestone.
+// The target index 0 is the latest solid milestone.
+// The commitments of the least recently requested milestones are evicted from the cache, since the ledger state of a milestone never changes.
+func (db *Database) GetLedgerStateCommitment(ctx context.Context, targetIndex milestone.Index) (*LedgerStateCommitment, error) {
+	if targetIndex == 0 {
+		targetIndex = db.GetSolidMilestoneIndex()
+	}
+
+	ctx, done := db.TrackMethod(ctx, "GetLedgerStateCommitment", AttributeMilestoneIndex.Int64(int64(targetIndex)))
+	resultCount := 0
+	defer func() { done(0, AttributeResultCount.Int(resultCount)) }()
+
+	commitment, err := db.ledgerStateCommitments.Get(ctx, targetIndex, func(ctx context.Context) (*LedgerStateCommitment, error) {
+		// the keys are counted by the nested call
+		balances, index, err := db.GetLedgerStateForMilestone(ctx, targetIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		return newLedgerStateCommitment(index, balances), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resultCount = commitment.Tree.LeafCount()
+
+	return commitment, nil
+}
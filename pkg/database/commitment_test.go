@@ -0,0 +1,68 @@
+package database
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/merkle"
+)
+
+func TestLedgerStateCommitment(t *testing.T) {
+	addressA := strings.Repeat("A", consts.HashTrytesSize)
+	addressB := strings.Repeat("B", consts.HashTrytesSize)
+	addressC := strings.Repeat("C", consts.HashTrytesSize)
+
+	balances := map[string]uint64{
+		string(hornet.HashFromAddressTrytes(addressC)): 30,
+		string(hornet.HashFromAddressTrytes(addressA)): 10,
+		string(hornet.HashFromAddressTrytes(addressB)): 0,
+	}
+	commitment := newLedgerStateCommitment(1, balances)
+
+	tests := []struct {
+		name        string
+		address     trinary.Hash
+		wantIndex   int
+		wantBalance uint64
+		wantFound   bool
+	}{
+		{name: "first leaf", address: addressA, wantIndex: 0, wantBalance: 10, wantFound: true},
+		{name: "second leaf", address: addressC, wantIndex: 1, wantBalance: 30, wantFound: true},
+		{name: "zero balance is not committed", address: addressB},
+		{name: "unknown address", address: strings.Repeat("D", consts.HashTrytesSize)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := commitment.LeafIndex(tt.address)
+			if found != tt.wantFound {
+				t.Fatalf("got found %t, want %t", found, tt.wantFound)
+			}
+
+			if !found {
+				return
+			}
+
+			if index != tt.wantIndex || commitment.Balances[index] != tt.wantBalance {
+				t.Fatalf("got leaf %d with balance %d, want leaf %d with balance %d", index, commitment.Balances[index], tt.wantIndex, tt.wantBalance)
+			}
+
+			proof, err := commitment.Tree.Proof(index)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !merkle.VerifyProof(merkle.LeafHash(LedgerStateLeaf(tt.address, tt.wantBalance)), proof, commitment.Tree.Root()) {
+				t.Fatal("inclusion proof is invalid")
+			}
+
+			if merkle.VerifyProof(merkle.LeafHash(LedgerStateLeaf(tt.address, tt.wantBalance+1)), proof, commitment.Tree.Root()) {
+				t.Fatal("inclusion proof is valid for a different balance")
+			}
+		})
+	}
+}
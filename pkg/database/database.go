@@ -78,6 +78,18 @@ type Database struct {
 
 	latestSolidMilestoneBundle     *Bundle
 	latestSolidMilestoneBundleOnce sync.Once
+
+	// funds on spent addresses, computed on first use
+	fundsOnSpentAddresses *resultCache[struct{}, *fundsOnSpentAddresses]
+
+	// balance distributions of recently requested milestones
+	balanceDistributions *resultCache[milestone.Index, *BalanceDistribution]
+
+	// ledger state commitments of recently requested milestones
+	ledgerStateCommitments *resultCache[milestone.Index, *LedgerStateCommitment]
+
+	// metrics of the database calls
+	metrics *metrics
 }
 
 func New(tangleDatabase, snapshotDatabase, spentDatabase kvstore.KVStore, skipHealthCheck bool) (*Database, error) {
@@ -136,6 +148,10 @@ func New(tangleDatabase, snapshotDatabase, spentDatabase kvstore.KVStore, skipHe
 		ledgerMilestoneIndexOnce:       sync.Once{},
 		latestSolidMilestoneBundle:     nil,
 		latestSolidMilestoneBundleOnce: sync.Once{},
+		fundsOnSpentAddresses:          newResultCache[struct{}, *fundsOnSpentAddresses](1),
+		balanceDistributions:           newResultCache[milestone.Index, *BalanceDistribution](maxCachedBalanceDistributions),
+		ledgerStateCommitments:         newResultCache[milestone.Index, *LedgerStateCommitment](maxCachedLedgerStateCommitments),
+		metrics:                        newMetrics(),
 	}
 
 	if err := db.loadSnapshotInfo(); err != nil {
@@ -0,0 +1,286 @@
+// Package databasetest stores transactions, bundles, milestones and the ledger in the storage layout
+// of the legacy node, so that code using the database can be tested without a database of the legacy network.
+package databasetest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/encoding/t5b1"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+)
+
+// NullHash is the hash that is approved by the first transactions of the tangle.
+var NullHash = strings.Repeat("9", consts.HashTrytesSize)
+
+// Tangle holds the stores of the legacy node.
+type Tangle struct {
+	t testing.TB
+
+	tangleDatabase   kvstore.KVStore
+	snapshotDatabase kvstore.KVStore
+	spentDatabase    kvstore.KVStore
+
+	solidEntryPoints []byte
+	txCount          int
+}
+
+// New returns an empty tangle with a pruning index of 0 and the null hash as solid entry point.
+func New(t testing.TB) *Tangle {
+	tangle := &Tangle{
+		t:                t,
+		tangleDatabase:   mapdb.NewMapDB(),
+		snapshotDatabase: mapdb.NewMapDB(),
+		spentDatabase:    mapdb.NewMapDB(),
+	}
+	tangle.SetSnapshot(0, false)
+	tangle.AddSolidEntryPoint(NullHash, 0)
+	tangle.SetLedgerIndex(0)
+
+	return tangle
+}
+
+func (tg *Tangle) store(db kvstore.KVStore, prefix byte) kvstore.KVStore {
+	return lo.PanicOnErr(db.WithRealm([]byte{prefix}))
+}
+
+func (tg *Tangle) set(store kvstore.KVStore, key []byte, value []byte) {
+	if err := store.Set(key, value); err != nil {
+		tg.t.Fatal(err)
+	}
+}
+
+func uint32Bytes(value uint32) []byte {
+	bytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(bytes, value)
+
+	return bytes
+}
+
+func uint64Bytes(value uint64) []byte {
+	bytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bytes, value)
+
+	return bytes
+}
+
+func join(parts ...[]byte) []byte {
+	var result []byte
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+
+	return result
+}
+
+// NewTransaction returns a zero value transaction that approves the given transactions.
+// Every returned transaction belongs to its own single transaction bundle, so it has a unique hash.
+func (tg *Tangle) NewTransaction(trunk trinary.Hash, branch trinary.Hash) *transaction.Transaction {
+	tg.txCount++
+
+	bundleHash := trinary.IntToTrytes(int64(tg.txCount), consts.HashTrytesSize)
+
+	return &transaction.Transaction{
+		SignatureMessageFragment: strings.Repeat("9", consts.SignatureMessageFragmentTrinarySize/consts.TritsPerTryte),
+		Address:                  NullHash,
+		ObsoleteTag:              strings.Repeat("9", consts.ObsoleteTagTrinarySize/consts.TritsPerTryte),
+		Bundle:                   bundleHash,
+		TrunkTransaction:         trunk,
+		BranchTransaction:        branch,
+		Tag:                      strings.Repeat("9", consts.TagTrinarySize/consts.TritsPerTryte),
+		Nonce:                    strings.Repeat("9", consts.NonceTrinarySize/consts.TritsPerTryte),
+	}
+}
+
+// AddTransaction stores the transaction with its metadata and indexes and sets its hash.
+// A confirmation index of 0 stores the transaction as unconfirmed.
+func (tg *Tangle) AddTransaction(tx *transaction.Transaction, confirmationIndex milestone.Index) trinary.Hash {
+	trits, err := transaction.TransactionToTrits(tx)
+	if err != nil {
+		tg.t.Fatal(err)
+	}
+	tx.Hash = transaction.TransactionHash(tx)
+
+	txHash := hornet.HashFromHashTrytes(tx.Hash)
+	trunkHash := hornet.HashFromHashTrytes(tx.TrunkTransaction)
+	branchHash := hornet.HashFromHashTrytes(tx.BranchTransaction)
+	bundleHash := hornet.HashFromHashTrytes(tx.Bundle)
+	address := hornet.HashFromAddressTrytes(tx.Address)
+	isTail := tx.CurrentIndex == 0
+	isHead := tx.CurrentIndex == tx.LastIndex
+	isValue := tx.Value != 0
+
+	txBytes := make([]byte, t5b1.EncodedLen(len(trits)))
+	t5b1.Encode(txBytes, trits)
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixTransactions), txHash, txBytes)
+
+	var metadata byte = 1 << database.TransactionMetadataSolid
+	if confirmationIndex != 0 {
+		metadata |= 1 << database.TransactionMetadataConfirmed
+	}
+	if isHead {
+		metadata |= 1 << database.TransactionMetadataIsHead
+	}
+	if isTail {
+		metadata |= 1 << database.TransactionMetadataIsTail
+	}
+	if isValue {
+		metadata |= 1 << database.TransactionMetadataIsValue
+	}
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixTransactionMetadata), txHash, join(
+		[]byte{metadata},
+		uint32Bytes(0),
+		uint32Bytes(uint32(confirmationIndex)),
+		make([]byte, 12),
+		trunkHash,
+		branchHash,
+		bundleHash,
+	))
+
+	approvers := tg.store(tg.tangleDatabase, database.StorePrefixApprovers)
+	tg.set(approvers, join(trunkHash, txHash), []byte{})
+	if tx.BranchTransaction != tx.TrunkTransaction {
+		tg.set(approvers, join(branchHash, txHash), []byte{})
+	}
+
+	var isValueByte byte
+	if isValue {
+		isValueByte = 1
+	}
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixAddresses), join(address, []byte{isValueByte}, txHash), []byte{})
+
+	var isTailByte byte
+	if isTail {
+		isTailByte = database.BundleTxIsTail
+	}
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixBundleTransactions), join(bundleHash, []byte{isTailByte}, txHash), []byte{})
+
+	return tx.Hash
+}
+
+// MarkConflicting marks the stored transaction as conflicting.
+func (tg *Tangle) MarkConflicting(txHash trinary.Hash) {
+	store := tg.store(tg.tangleDatabase, database.StorePrefixTransactionMetadata)
+
+	metadata, err := store.Get(hornet.HashFromHashTrytes(txHash))
+	if err != nil {
+		tg.t.Fatal(err)
+	}
+	metadata[0] |= 1 << database.TransactionMetadataConflicting
+
+	tg.set(store, hornet.HashFromHashTrytes(txHash), metadata)
+}
+
+// AddBundle stores the bundle of the given stored transactions, ordered from tail to head.
+// The ledger changes map the addresses to their balance changes.
+func (tg *Tangle) AddBundle(txs []*transaction.Transaction, valid bool, ledgerChanges map[trinary.Hash]int64) {
+	tail := txs[0]
+	head := txs[len(txs)-1]
+
+	var metadata byte = 1 << database.MetadataSolid
+	if valid {
+		metadata |= 1 << database.MetadataValid
+	}
+
+	value := join(
+		[]byte{metadata},
+		uint64Bytes(tail.LastIndex),
+		uint64Bytes(uint64(len(txs))),
+		uint64Bytes(uint64(len(ledgerChanges))),
+		hornet.HashFromHashTrytes(tail.Bundle),
+		hornet.HashFromHashTrytes(head.Hash),
+	)
+	for _, tx := range txs {
+		value = join(value, hornet.HashFromHashTrytes(tx.Hash))
+	}
+	for address, change := range ledgerChanges {
+		value = join(value, hornet.HashFromAddressTrytes(address), uint64Bytes(uint64(change)))
+	}
+
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixBundles), hornet.HashFromHashTrytes(tail.Hash), value)
+}
+
+// AddMilestone stores a valid single transaction milestone bundle for the given index, which approves the given transactions.
+// All the transactions of the past cone of the milestone have to be stored with its index as confirmation index by the caller.
+func (tg *Tangle) AddMilestone(index milestone.Index, trunk trinary.Hash, branch trinary.Hash) trinary.Hash {
+	tx := tg.NewTransaction(trunk, branch)
+	tx.ObsoleteTag = trinary.IntToTrytes(int64(index), consts.ObsoleteTagTrinarySize/consts.TritsPerTryte)
+
+	msHash := tg.AddTransaction(tx, index)
+	tg.AddBundle([]*transaction.Transaction{tx}, true, nil)
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixMilestones), uint32Bytes(uint32(index)), hornet.HashFromHashTrytes(msHash))
+
+	return msHash
+}
+
+// SetLedgerIndex sets the index of the milestone the ledger state belongs to.
+func (tg *Tangle) SetLedgerIndex(index milestone.Index) {
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixLedgerState), []byte("ledgerMilestoneIndex"), uint32Bytes(uint32(index)))
+}
+
+// SetBalance sets the balance of the address in the ledger state.
+// The ledger state has to sum up to the total supply for functions that load the whole ledger.
+func (tg *Tangle) SetBalance(address trinary.Hash, balance uint64) {
+	tg.set(tg.store(tg.tangleDatabase, database.StorePrefixLedgerBalance), hornet.HashFromAddressTrytes(address), uint64Bytes(balance))
+}
+
+// AddLedgerDiff stores the balance changes of the milestone, which have to sum up to zero.
+func (tg *Tangle) AddLedgerDiff(index milestone.Index, changes map[trinary.Hash]int64) {
+	store := tg.store(tg.tangleDatabase, database.StorePrefixLedgerDiff)
+	for address, change := range changes {
+		tg.set(store, join(uint32Bytes(uint32(index)), hornet.HashFromAddressTrytes(address)), uint64Bytes(uint64(change)))
+	}
+}
+
+// AddSpentAddress marks the address as spent.
+func (tg *Tangle) AddSpentAddress(address trinary.Hash) {
+	tg.set(tg.store(tg.spentDatabase, database.StorePrefixSpentAddresses), hornet.HashFromAddressTrytes(address), []byte{})
+}
+
+// SetSnapshot sets the pruning index of the snapshot and whether the spent addresses were tracked.
+func (tg *Tangle) SetSnapshot(pruningIndex milestone.Index, spentAddressesEnabled bool) {
+	var metadata byte
+	if spentAddressesEnabled {
+		metadata |= 1 << database.SnapshotMetadataSpentAddressesEnabled
+	}
+
+	tg.set(tg.store(tg.snapshotDatabase, database.StorePrefixSnapshot), []byte("snapshotInfo"), join(
+		hornet.HashFromHashTrytes(NullHash),
+		hornet.HashFromHashTrytes(NullHash),
+		uint32Bytes(uint32(pruningIndex)),
+		uint32Bytes(uint32(pruningIndex)),
+		uint32Bytes(uint32(pruningIndex)),
+		uint64Bytes(0),
+		[]byte{metadata},
+	))
+}
+
+// AddSolidEntryPoint adds the transaction to the solid entry points of the snapshot.
+func (tg *Tangle) AddSolidEntryPoint(txHash trinary.Hash, index milestone.Index) {
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, uint32(index))
+
+	tg.solidEntryPoints = join(tg.solidEntryPoints, hornet.HashFromHashTrytes(txHash), indexBytes)
+	tg.set(tg.store(tg.snapshotDatabase, database.StorePrefixSnapshot), []byte("solidEntryPoints"), tg.solidEntryPoints)
+}
+
+// Database opens the database on the stores of the tangle.
+// The database caches the snapshot and the ledger index, so it has to be opened after the tangle was built.
+func (tg *Tangle) Database() *database.Database {
+	db, err := database.New(tg.tangleDatabase, tg.snapshotDatabase, tg.spentDatabase, true)
+	if err != nil {
+		tg.t.Fatal(fmt.Errorf("opening database failed: %w", err))
+	}
+
+	return db
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+const (
+	// maxCachedBalanceDistributions is the amount of milestones whose balance distribution is kept in memory.
+	maxCachedBalanceDistributions = 8
+)
+
+// BalanceBucket contains the addresses whose balance is in the range [MinBalance, MaxBalance).
+type BalanceBucket struct {
+	MinBalance uint64
+	MaxBalance uint64
+	Addresses  int
+	Balance    uint64
+}
+
+// BalanceDistribution is the distribution of the balances of the ledger state at a milestone.
+type BalanceDistribution struct {
+	MilestoneIndex milestone.Index
+	// Holders are all addresses with a non-zero balance, ordered by balance descending and address ascending.
+	Holders []*AddressBalance
+	// TotalBalance is the sum of the balances of all holders.
+	TotalBalance uint64
+	// Buckets are the holders grouped by the decimal order of magnitude of their balance.
+	Buckets []*BalanceBucket
+	// Gini is the Gini coefficient of the balances of all holders.
+	Gini float64
+}
+
+// newBalanceDistribution computes the distribution of the given balances.
+func newBalanceDistribution(milestoneIndex milestone.Index, balances map[string]uint64) *BalanceDistribution {
+	distribution := &BalanceDistribution{
+		MilestoneIndex: milestoneIndex,
+		Holders:        make([]*AddressBalance, 0, len(balances)),
+		Buckets:        make([]*BalanceBucket, 0),
+	}
+
+	for address, balance := range balances {
+		if balance == 0 {
+			continue
+		}
+
+		distribution.Holders = append(distribution.Holders, &AddressBalance{
+			Address: hornet.Hash(address),
+			Balance: balance,
+		})
+		distribution.TotalBalance += balance
+	}
+
+	// the address is the tie-breaker, so the order is stable between requests
+	sort.Slice(distribution.Holders, func(i, j int) bool {
+		if distribution.Holders[i].Balance != distribution.Holders[j].Balance {
+			return distribution.Holders[i].Balance > distribution.Holders[j].Balance
+		}
+
+		return bytes.Compare(distribution.Holders[i].Address, distribution.Holders[j].Address) < 0
+	})
+
+	for _, holder := range distribution.Holders {
+		bucketIndex := 0
+		for minBalance := uint64(10); minBalance <= holder.Balance; minBalance *= 10 {
+			bucketIndex++
+		}
+
+		for len(distribution.Buckets) <= bucketIndex {
+			minBalance := uint64(1)
+			if len(distribution.Buckets) > 0 {
+				minBalance = distribution.Buckets[len(distribution.Buckets)-1].MaxBalance
+			}
+
+			distribution.Buckets = append(distribution.Buckets, &BalanceBucket{
+				MinBalance: minBalance,
+				MaxBalance: minBalance * 10,
+			})
+		}
+
+		distribution.Buckets[bucketIndex].Addresses++
+		distribution.Buckets[bucketIndex].Balance += holder.Balance
+	}
+
+	distribution.Gini = giniCoefficient(distribution.Holders, distribution.TotalBalance)
+
+	return distribution
+}
+
+// giniCoefficient computes the Gini coefficient of the given holders, which are ordered by balance descending.
+// It is 0 if all holders have the same balance and approaches 1 if a single holder owns everything.
+func giniCoefficient(holders []*AddressBalance, totalBalance uint64) float64 {
+	count := len(holders)
+	if count == 0 || totalBalance == 0 {
+		return 0
+	}
+
+	// G = 2 * sum(i * x_i) / (n * sum(x_i)) - (n + 1) / n, with x_i ordered ascending and i starting at 1
+	var weightedSum float64
+	for i, holder := range holders {
+		weightedSum += float64(count-i) * float64(holder.Balance)
+	}
+
+	n := float64(count)
+
+	return 2*weightedSum/(n*float64(totalBalance)) - (n+1)/n
+}
+
+// GetBalanceDistribution returns the distribution of the balances of the ledger state at the given milestone.
+// The target index 0 is the latest solid milestone.
+// The distributions of the least recently requested milestones are evicted from the cache, since the ledger state of a milestone never changes.
+func (db *Database) GetBalanceDistribution(ctx context.Context, targetIndex milestone.Index) (*BalanceDistribution, error) {
+	if targetIndex == 0 {
+		targetIndex = db.GetSolidMilestoneIndex()
+	}
+
+	ctx, done := db.TrackMethod(ctx, "GetBalanceDistribution", AttributeMilestoneIndex.Int64(int64(targetIndex)))
+	resultCount := 0
+	defer func() { done(0, AttributeResultCount.Int(resultCount)) }()
+
+	distribution, err := db.balanceDistributions.Get(ctx, targetIndex, func(ctx context.Context) (*BalanceDistribution, error) {
+		// the keys are counted by the nested call
+		balances, index, err := db.GetLedgerStateForMilestone(ctx, targetIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		return newBalanceDistribution(index, balances), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resultCount = len(distribution.Holders)
+
+	return distribution, nil
+}
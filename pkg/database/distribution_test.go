@@ -0,0 +1,118 @@
+package database
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewBalanceDistribution(t *testing.T) {
+	tests := []struct {
+		name             string
+		balances         map[string]uint64
+		wantHolders      []string
+		wantBucketCounts []int
+		wantGini         float64
+	}{
+		{
+			name:     "empty ledger",
+			balances: map[string]uint64{},
+		},
+		{
+			name:             "equal balances",
+			balances:         map[string]uint64{"b": 5, "a": 5, "c": 0},
+			wantHolders:      []string{"a", "b"},
+			wantBucketCounts: []int{2},
+			wantGini:         0,
+		},
+		{
+			name:             "single holder owns almost everything",
+			balances:         map[string]uint64{"a": 1, "b": 1, "c": 1, "d": 997},
+			wantHolders:      []string{"d", "a", "b", "c"},
+			wantBucketCounts: []int{3, 0, 1},
+			wantGini:         0.747,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distribution := newBalanceDistribution(1, tt.balances)
+
+			if len(distribution.Holders) != len(tt.wantHolders) {
+				t.Fatalf("got %d holders, want %d", len(distribution.Holders), len(tt.wantHolders))
+			}
+			for i, holder := range distribution.Holders {
+				if string(holder.Address) != tt.wantHolders[i] {
+					t.Fatalf("got holder %q at position %d, want %q", holder.Address, i, tt.wantHolders[i])
+				}
+			}
+
+			if len(distribution.Buckets) != len(tt.wantBucketCounts) {
+				t.Fatalf("got %d buckets, want %d", len(distribution.Buckets), len(tt.wantBucketCounts))
+			}
+			for i, bucket := range distribution.Buckets {
+				if bucket.Addresses != tt.wantBucketCounts[i] {
+					t.Fatalf("got %d addresses in bucket %d, want %d", bucket.Addresses, i, tt.wantBucketCounts[i])
+				}
+			}
+
+			if math.Abs(distribution.Gini-tt.wantGini) > 1e-9 {
+				t.Fatalf("got gini coefficient %f, want %f", distribution.Gini, tt.wantGini)
+			}
+		})
+	}
+}
+
+func TestBalanceDistributionBuckets(t *testing.T) {
+	distribution := newBalanceDistribution(1, map[string]uint64{"a": 1, "b": 9, "c": 10, "d": 99, "e": 1000})
+
+	// the buckets cover the decimal orders of magnitude without gaps, even if they are empty
+	want := []BalanceBucket{
+		{MinBalance: 1, MaxBalance: 10, Addresses: 2, Balance: 10},
+		{MinBalance: 10, MaxBalance: 100, Addresses: 2, Balance: 109},
+		{MinBalance: 100, MaxBalance: 1000, Addresses: 0, Balance: 0},
+		{MinBalance: 1000, MaxBalance: 10000, Addresses: 1, Balance: 1000},
+	}
+
+	if len(distribution.Buckets) != len(want) {
+		t.Fatalf("got %d buckets, want %d", len(distribution.Buckets), len(want))
+	}
+	for i, bucket := range distribution.Buckets {
+		if *bucket != want[i] {
+			t.Fatalf("got bucket %+v at position %d, want %+v", *bucket, i, want[i])
+		}
+	}
+
+	if distribution.TotalBalance != 1119 {
+		t.Fatalf("got total balance %d, want 1119", distribution.TotalBalance)
+	}
+}
+
+func TestGiniCoefficient(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances []uint64
+		want     float64
+	}{
+		{name: "no holders", want: 0},
+		{name: "single holder", balances: []uint64{100}, want: 0},
+		{name: "equal balances", balances: []uint64{7, 7, 7}, want: 0},
+		{name: "two holders", balances: []uint64{3, 1}, want: 0.25},
+		{name: "one of four holders owns everything but one", balances: []uint64{97, 1, 1, 1}, want: 0.72},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the holders are ordered by balance descending
+			holders := make([]*AddressBalance, 0, len(tt.balances))
+			var totalBalance uint64
+			for _, balance := range tt.balances {
+				holders = append(holders, &AddressBalance{Balance: balance})
+				totalBalance += balance
+			}
+
+			if got := giniCoefficient(holders, totalBalance); math.Abs(got-tt.want) > 1e-9 {
+				t.Fatalf("got gini coefficient %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,3 +14,16 @@ func NewBoltDB(path string) (*bbolt.DB, error) {
 
 	return bolt.CreateDB(path, opts)
 }
+
+// boltStats returns the engine-level statistics of a bolt DB instance.
+func boltStats(db *bbolt.DB) map[string]float64 {
+	stats := db.Stats()
+
+	return map[string]float64{
+		"free_pages":             float64(stats.FreePageN),
+		"pending_pages":          float64(stats.PendingPageN),
+		"free_alloc_bytes":       float64(stats.FreeAlloc),
+		"read_transactions":      float64(stats.TxN),
+		"open_read_transactions": float64(stats.OpenTxN),
+	}
+}
@@ -32,9 +32,19 @@ var (
 	AllowedEnginesStorageAuto = append(AllowedEnginesStorage, hivedb.EngineAuto)
 )
 
+// Store is a kvstore that also gives access to the engine-level statistics of the underlying database.
+type Store struct {
+	kvstore.KVStore
+
+	// Engine is the engine of the underlying database.
+	Engine hivedb.Engine
+	// Stats returns the engine-level statistics of the underlying database by name.
+	Stats func() map[string]float64
+}
+
 // StoreWithDefaultSettings returns a kvstore with default settings.
 // It also checks if the database engine is correct.
-func StoreWithDefaultSettings(directory string, createDatabaseIfNotExists bool, dbEngine hivedb.Engine, boltFileName string, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
+func StoreWithDefaultSettings(directory string, createDatabaseIfNotExists bool, dbEngine hivedb.Engine, boltFileName string, allowedEngines ...hivedb.Engine) (*Store, error) {
 
 	tmpAllowedEngines := AllowedEnginesDefault
 	if len(allowedEngines) > 0 {
@@ -54,7 +64,11 @@ func StoreWithDefaultSettings(directory string, createDatabaseIfNotExists bool,
 			return nil, err
 		}
 
-		return pebble.New(db), nil
+		return &Store{
+			KVStore: pebble.New(db),
+			Engine:  targetEngine,
+			Stats:   func() map[string]float64 { return pebbleStats(db) },
+		}, nil
 
 	case hivedb.EngineRocksDB:
 		db, err := NewRocksDB(directory)
@@ -62,7 +76,11 @@ func StoreWithDefaultSettings(directory string, createDatabaseIfNotExists bool,
 			return nil, err
 		}
 
-		return rocksdb.New(db), nil
+		return &Store{
+			KVStore: rocksdb.New(db),
+			Engine:  targetEngine,
+			Stats:   func() map[string]float64 { return rocksDBStats(db) },
+		}, nil
 
 	case EngineBolt:
 		db, err := NewBoltDB(path.Join(directory, boltFileName))
@@ -70,7 +88,11 @@ func StoreWithDefaultSettings(directory string, createDatabaseIfNotExists bool,
 			return nil, err
 		}
 
-		return bolt.New(db), nil
+		return &Store{
+			KVStore: bolt.New(db),
+			Engine:  targetEngine,
+			Stats:   func() map[string]float64 { return boltStats(db) },
+		}, nil
 
 	default:
 		return nil, fmt.Errorf("unknown database engine: %s, supported engines: pebble/rocksdb/bolt", dbEngine)
@@ -240,3 +240,18 @@ func NewPebbleDB(directory string, reportCompactionRunning func(running bool), e
 
 	return pebble.CreateDB(directory, opts)
 }
+
+// pebbleStats returns the engine-level statistics of a pebble DB instance.
+func pebbleStats(db *pebbleDB.DB) map[string]float64 {
+	metrics := db.Metrics()
+
+	return map[string]float64{
+		"disk_space_usage_bytes": float64(metrics.DiskSpaceUsage()),
+		"read_amplification":     float64(metrics.ReadAmp()),
+		"block_cache_size_bytes": float64(metrics.BlockCache.Size),
+		"block_cache_hits":       float64(metrics.BlockCache.Hits),
+		"block_cache_misses":     float64(metrics.BlockCache.Misses),
+		"compactions":            float64(metrics.Compact.Count),
+		"memtable_size_bytes":    float64(metrics.MemTable.Size),
+	}
+}
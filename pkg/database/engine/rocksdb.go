@@ -21,3 +21,26 @@ func NewRocksDB(path string) (*rocksdb.RocksDB, error) {
 
 	return rocksdb.CreateDB(path, opts...)
 }
+
+// rocksDBStatsProperties are the integer properties of a RocksDB instance that are exposed as engine-level statistics.
+var rocksDBStatsProperties = map[string]string{
+	"live_data_size_bytes":       "rocksdb.estimate-live-data-size",
+	"sst_files_size_bytes":       "rocksdb.total-sst-files-size",
+	"block_cache_usage_bytes":    "rocksdb.block-cache-usage",
+	"memtable_size_bytes":        "rocksdb.cur-size-all-mem-tables",
+	"estimated_keys":             "rocksdb.estimate-num-keys",
+	"running_compactions":        "rocksdb.num-running-compactions",
+	"table_readers_memory_bytes": "rocksdb.estimate-table-readers-mem",
+}
+
+// rocksDBStats returns the engine-level statistics of a RocksDB instance.
+func rocksDBStats(db *rocksdb.RocksDB) map[string]float64 {
+	stats := make(map[string]float64, len(rocksDBStatsProperties))
+	for name, property := range rocksDBStatsProperties {
+		if value, ok := db.GetIntProperty(property); ok {
+			stats[name] = float64(value)
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+)
+
+// CheckStoresReadable checks that all databases are open and can be read.
+func (db *Database) CheckStoresReadable(_ context.Context) error {
+	for _, database := range []struct {
+		name  string
+		store kvstore.KVStore
+	}{
+		{name: "tangle", store: db.tangleDatabase},
+		{name: "snapshot", store: db.snapshotDatabase},
+		{name: "spent", store: db.spentDatabase},
+	} {
+		if _, err := database.store.Has(kvstore.Key{StorePrefixHealth}); err != nil {
+			return fmt.Errorf("%s database is not readable: %w", database.name, err)
+		}
+	}
+
+	return nil
+}
+
+// CheckLedger checks that the ledger index and the latest solid milestone bundle can be loaded from the database.
+// Other than GetLedgerIndex and GetLatestSolidMilestoneBundle it reads the values from the database every time
+// and returns an error instead of panicking.
+func (db *Database) CheckLedger(_ context.Context) error {
+	value, err := db.ledgerStore.Get([]byte(ledgerMilestoneIndexKey))
+	if err != nil {
+		return fmt.Errorf("failed to load ledger milestone index: %w", err)
+	}
+	ledgerIndex := milestoneIndexFromBytes(value)
+
+	milestoneData, err := db.milestoneStore.Get(databaseKeyForMilestoneIndex(ledgerIndex))
+	if err != nil {
+		return fmt.Errorf("failed to load latest solid milestone %d: %w", ledgerIndex, err)
+	}
+	milestone := milestoneFactory(databaseKeyForMilestoneIndex(ledgerIndex), milestoneData)
+
+	bundleKey := databaseKeyForBundle(milestone.Hash)
+	bundleData, err := db.bundleStore.Get(bundleKey)
+	if err != nil {
+		return fmt.Errorf("failed to load latest solid milestone bundle %d: %w", ledgerIndex, err)
+	}
+
+	if _, err := bundleFactory(db, bundleKey, bundleData); err != nil {
+		return fmt.Errorf("failed to parse latest solid milestone bundle %d: %w", ledgerIndex, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// AttributeMilestoneIndex is the span attribute of the milestone index of a database call.
+	AttributeMilestoneIndex = attribute.Key("iota.milestone_index")
+	// AttributeKeysIterated is the span attribute of the amount of keys read by a database call.
+	AttributeKeysIterated = attribute.Key("db.keys_iterated")
+	// AttributeResultCount is the span attribute of the amount of results of a database call.
+	AttributeResultCount = attribute.Key("db.result_count")
+	// AttributeLookup is the span attribute that marks the lookup of a single entry.
+	// Lookups are done for every visited transaction of a walk, so their spans may be sampled separately.
+	AttributeLookup = attribute.Key("db.lookup")
+)
+
+// tracer creates the spans of the database calls.
+// It is a no-op as long as no tracer provider is registered.
+var tracer = otel.Tracer("github.com/iotaledger/inx-api-core-v0/pkg/database")
+
+// metrics holds the prometheus metrics of the database calls.
+type metrics struct {
+	methodDuration *prometheus.HistogramVec
+	keysIterated   *prometheus.HistogramVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		methodDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "iota",
+				Subsystem: "database",
+				Name:      "method_duration_seconds",
+				Help:      "The duration of the database calls per method.",
+				Buckets:   []float64{0.0001, 0.001, 0.01, 0.1, 0.5, 1, 5, 10, 30, 60},
+			},
+			[]string{"method"},
+		),
+		keysIterated: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "iota",
+				Subsystem: "database",
+				Name:      "keys_iterated",
+				Help:      "The number of keys read by the database calls per method.",
+				Buckets:   prometheus.ExponentialBuckets(1, 10, 8),
+			},
+			[]string{"method"},
+		),
+	}
+}
+
+// TrackMethod starts measuring and tracing a call of the given database method.
+// The returned context contains the span of the call and should be passed to nested database calls.
+// The returned function must be called with the amount of read keys after the call finished.
+func (db *Database) TrackMethod(ctx context.Context, method string, attributes ...attribute.KeyValue) (context.Context, func(keysIterated int, attributes ...attribute.KeyValue)) {
+	start := time.Now()
+
+	ctx, span := tracer.Start(ctx, "Database."+method, trace.WithAttributes(attributes...))
+
+	return ctx, func(keysIterated int, attributes ...attribute.KeyValue) {
+		span.SetAttributes(AttributeKeysIterated.Int(keysIterated))
+		span.SetAttributes(attributes...)
+		span.End()
+
+		db.metrics.methodDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		db.metrics.keysIterated.WithLabelValues(method).Observe(float64(keysIterated))
+	}
+}
+
+// trackLookup starts measuring and tracing the lookup of a single entry, see TrackMethod.
+func (db *Database) trackLookup(ctx context.Context, method string, attributes ...attribute.KeyValue) (context.Context, func(keysIterated int, attributes ...attribute.KeyValue)) {
+	return db.TrackMethod(ctx, method, append([]attribute.KeyValue{AttributeLookup.Bool(true)}, attributes...)...)
+}
+
+// keysFound returns the amount of read keys of a lookup of a single key.
+func keysFound(found bool) int {
+	if found {
+		return 1
+	}
+
+	return 0
+}
+
+// Collectors returns the prometheus collectors of the database calls and the milestone indexes of the database.
+func (db *Database) Collectors() []prometheus.Collector {
+	newIndexGauge := func(name string, help string, index func() float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Namespace: "iota",
+				Subsystem: "database",
+				Name:      name,
+				Help:      help,
+			},
+			index,
+		)
+	}
+
+	return []prometheus.Collector{
+		db.metrics.methodDuration,
+		db.metrics.keysIterated,
+		newIndexGauge("ledger_index", "The ledger index of the database.", func() float64 {
+			return float64(db.GetLedgerIndex())
+		}),
+		newIndexGauge("solid_milestone_index", "The latest solid milestone index of the database.", func() float64 {
+			return float64(db.GetSolidMilestoneIndex())
+		}),
+		newIndexGauge("snapshot_index", "The snapshot index of the database.", func() float64 {
+			return float64(db.snapshot.SnapshotIndex)
+		}),
+		newIndexGauge("entry_point_index", "The solid entry point index of the database.", func() float64 {
+			return float64(db.snapshot.EntryPointIndex)
+		}),
+		newIndexGauge("pruning_index", "The pruning index of the database.", func() float64 {
+			return float64(db.snapshot.PruningIndex)
+		}),
+	}
+}
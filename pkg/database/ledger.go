@@ -33,7 +33,16 @@ func milestoneIndexFromBytes(bytes []byte) milestone.Index {
 	return milestone.Index(binary.LittleEndian.Uint32(bytes))
 }
 
+// GetBalanceForAddress returns the balance of the given address at the ledger index.
 func (db *Database) GetBalanceForAddress(address hornet.Hash) (uint64, milestone.Index, error) {
+	return db.GetBalanceForAddressContext(context.Background(), address)
+}
+
+// GetBalanceForAddressContext is like GetBalanceForAddress, but traces the call in the span of the given context.
+func (db *Database) GetBalanceForAddressContext(ctx context.Context, address hornet.Hash) (uint64, milestone.Index, error) {
+	_, done := db.trackLookup(ctx, "GetBalanceForAddress")
+	keysIterated := 0
+	defer func() { done(keysIterated) }()
 
 	value, err := db.ledgerBalanceStore.Get(databaseKeyForAddress(address))
 	if err != nil {
@@ -43,27 +52,72 @@ func (db *Database) GetBalanceForAddress(address hornet.Hash) (uint64, milestone
 
 		return 0, db.GetLedgerIndex(), nil
 	}
+	keysIterated++
 
 	return balanceFromBytes(value), db.GetLedgerIndex(), err
 }
 
+// AddressDiffConsumer consumes the balance change of an address.
+// Returning false stops the iteration.
+type AddressDiffConsumer func(address hornet.Hash, change int64) bool
+
+// AddressBalanceConsumer consumes the balance of an address.
+// Returning false stops the iteration.
+type AddressBalanceConsumer func(address hornet.Hash, balance uint64) bool
+
 // GetLedgerDiffForMilestone returns the ledger changes of that specific milestone.
 func (db *Database) GetLedgerDiffForMilestone(ctx context.Context, targetIndex milestone.Index) (map[string]int64, error) {
+	ctx, done := db.TrackMethod(ctx, "GetLedgerDiffForMilestone", AttributeMilestoneIndex.Int64(int64(targetIndex)))
+	keysIterated := 0
+	resultCount := 0
+	defer func() { done(keysIterated, AttributeResultCount.Int(resultCount)) }()
+
+	diff := make(map[string]int64)
+
+	var err error
+	keysIterated, err = db.forEachLedgerDiffChange(ctx, targetIndex, func(address hornet.Hash, change int64) bool {
+		diff[string(address)] = change
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	resultCount = len(diff)
+
+	return diff, nil
+}
 
+// ForEachLedgerDiffChange passes the ledger changes of that specific milestone to the consumer
+// while iterating them, so the diff is never held in memory.
+func (db *Database) ForEachLedgerDiffChange(ctx context.Context, targetIndex milestone.Index, consumer AddressDiffConsumer) error {
+	ctx, done := db.TrackMethod(ctx, "ForEachLedgerDiffChange", AttributeMilestoneIndex.Int64(int64(targetIndex)))
+	keysIterated := 0
+	defer func() { done(keysIterated) }()
+
+	var err error
+	keysIterated, err = db.forEachLedgerDiffChange(ctx, targetIndex, consumer)
+
+	return err
+}
+
+// forEachLedgerDiffChange passes the ledger changes of the milestone to the consumer and returns the amount of read keys.
+func (db *Database) forEachLedgerDiffChange(ctx context.Context, targetIndex milestone.Index, consumer AddressDiffConsumer) (int, error) {
 	solidMilestoneIndex := db.GetSolidMilestoneIndex()
 	if targetIndex > solidMilestoneIndex {
-		return nil, fmt.Errorf("target index is too new. maximum: %d, actual: %d", solidMilestoneIndex, targetIndex)
+		return 0, fmt.Errorf("target index is too new. maximum: %d, actual: %d", solidMilestoneIndex, targetIndex)
 	}
 
 	if targetIndex <= db.snapshot.PruningIndex {
-		return nil, fmt.Errorf("target index is too old. minimum: %d, actual: %d", db.snapshot.PruningIndex+1, targetIndex)
+		return 0, fmt.Errorf("target index is too old. minimum: %d, actual: %d", db.snapshot.PruningIndex+1, targetIndex)
 	}
 
-	diff := make(map[string]int64)
-
 	keyPrefix := databaseKeyForMilestoneIndex(targetIndex)
 
+	keysIterated := 0
 	aborted := false
+	stopped := false
+	var diffSum int64
 	err := db.ledgerDiffStore.Iterate(keyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
 		select {
 		case <-ctx.Done():
@@ -72,33 +126,42 @@ func (db *Database) GetLedgerDiffForMilestone(ctx context.Context, targetIndex m
 			return false
 		default:
 		}
+		keysIterated++
+
+		change := diffFromBytes(value)
+		diffSum += change
+
 		// Remove prefix from key
-		diff[string(key[len(keyPrefix):len(keyPrefix)+49])] = diffFromBytes(value)
+		if !consumer(hornet.Hash(key[len(keyPrefix):len(keyPrefix)+49]), change) {
+			stopped = true
+
+			return false
+		}
 
 		return true
 	})
 
 	if err != nil {
-		return nil, err
+		return keysIterated, err
 	}
 
 	if aborted {
-		return nil, ErrOperationAborted
-	}
-
-	var diffSum int64
-	for _, change := range diff {
-		diffSum += change
+		return keysIterated, ErrOperationAborted
 	}
 
-	if diffSum != 0 {
+	// the sum can only be checked if the consumer didn't stop the iteration
+	if !stopped && diffSum != 0 {
 		panic(fmt.Sprintf("GetLedgerDiffForMilestone(): Ledger diff for milestone %d does not sum up to zero", targetIndex))
 	}
 
-	return diff, nil
+	return keysIterated, nil
 }
 
 func (db *Database) GetLedgerStateForMilestone(ctx context.Context, targetIndex milestone.Index) (map[string]uint64, milestone.Index, error) {
+	ctx, done := db.TrackMethod(ctx, "GetLedgerStateForMilestone", AttributeMilestoneIndex.Int64(int64(targetIndex)))
+	keysIterated := 0
+	resultCount := 0
+	defer func() { done(keysIterated, AttributeResultCount.Int(resultCount)) }()
 
 	solidMilestoneIndex := db.GetSolidMilestoneIndex()
 	if targetIndex == 0 {
@@ -122,6 +185,8 @@ func (db *Database) GetLedgerStateForMilestone(ctx context.Context, targetIndex
 		return nil, 0, fmt.Errorf("getLedgerStateForLSMI failed! %w", err)
 	}
 
+	keysIterated += len(balances)
+
 	if ledgerMilestone != solidMilestoneIndex {
 		return nil, 0, fmt.Errorf("ledgerMilestone wrong! %d/%d", ledgerMilestone, solidMilestoneIndex)
 	}
@@ -136,6 +201,7 @@ func (db *Database) GetLedgerStateForMilestone(ctx context.Context, targetIndex
 
 			return nil, 0, fmt.Errorf("getLedgerDiffForMilestone: %w", err)
 		}
+		keysIterated += len(diff)
 
 		for address, change := range diff {
 			select {
@@ -157,11 +223,16 @@ func (db *Database) GetLedgerStateForMilestone(ctx context.Context, targetIndex
 		}
 	}
 
+	resultCount = len(balances)
+
 	return balances, targetIndex, nil
 }
 
 // GetLedgerStateForLSMI returns all balances for the current solid milestone.
 func (db *Database) GetLedgerStateForLSMI(ctx context.Context) (map[string]uint64, milestone.Index, error) {
+	_, done := db.TrackMethod(ctx, "GetLedgerStateForLSMI")
+	keysIterated := 0
+	defer func() { done(keysIterated) }()
 
 	balances := make(map[string]uint64)
 
@@ -175,6 +246,8 @@ func (db *Database) GetLedgerStateForLSMI(ctx context.Context) (map[string]uint6
 		default:
 		}
 
+		keysIterated++
+
 		balances[string(key[:49])] = balanceFromBytes(value)
 
 		return true
@@ -198,3 +271,169 @@ func (db *Database) GetLedgerStateForLSMI(ctx context.Context) (map[string]uint6
 
 	return balances, db.GetLedgerIndex(), err
 }
+
+// ForEachBalanceForMilestone passes all balances at the target milestone to the consumer and returns the index of the milestone.
+// A target index of 0 selects the latest solid milestone.
+// The balances are computed while iterating the ledger state of the latest solid milestone,
+// so only the changes of the milestones after the target milestone are held in memory.
+func (db *Database) ForEachBalanceForMilestone(ctx context.Context, targetIndex milestone.Index, consumer AddressBalanceConsumer) (milestone.Index, error) {
+	ctx, done := db.TrackMethod(ctx, "ForEachBalanceForMilestone", AttributeMilestoneIndex.Int64(int64(targetIndex)))
+	keysIterated := 0
+	defer func() { done(keysIterated) }()
+
+	solidMilestoneIndex := db.GetSolidMilestoneIndex()
+	if targetIndex == 0 {
+		targetIndex = solidMilestoneIndex
+	}
+
+	if targetIndex > solidMilestoneIndex {
+		return 0, fmt.Errorf("target index is too new. maximum: %d, actual: %d", solidMilestoneIndex, targetIndex)
+	}
+
+	if targetIndex <= db.snapshot.PruningIndex {
+		return 0, fmt.Errorf("target index is too old. minimum: %d, actual: %d", db.snapshot.PruningIndex+1, targetIndex)
+	}
+
+	if ledgerMilestone := db.GetLedgerIndex(); ledgerMilestone != solidMilestoneIndex {
+		return 0, fmt.Errorf("ledgerMilestone wrong! %d/%d", ledgerMilestone, solidMilestoneIndex)
+	}
+
+	// the changes of the milestones after the target milestone are rolled back
+	rollback := make(map[string]int64)
+	for milestoneIndex := solidMilestoneIndex; milestoneIndex > targetIndex; milestoneIndex-- {
+		diffKeysIterated, err := db.forEachLedgerDiffChange(ctx, milestoneIndex, func(address hornet.Hash, change int64) bool {
+			rollback[string(address)] += change
+
+			return true
+		})
+		keysIterated += diffKeysIterated
+		if err != nil {
+			if errors.Is(err, ErrOperationAborted) {
+				return 0, err
+			}
+
+			return 0, fmt.Errorf("getLedgerDiffForMilestone: %w", err)
+		}
+	}
+
+	var balanceErr error
+	stopped := false
+	var total uint64
+	consume := func(address hornet.Hash, balance int64) bool {
+		switch {
+		case balance < 0:
+			balanceErr = fmt.Errorf("ledger diffs after milestone %d create negative balance for address %s: %d", targetIndex, address.Trytes(), balance)
+
+			return false
+		case balance == 0:
+			return true
+		}
+		total += uint64(balance)
+
+		if !consumer(address, uint64(balance)) {
+			stopped = true
+
+			return false
+		}
+
+		return true
+	}
+
+	aborted := false
+	err := db.ledgerBalanceStore.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		select {
+		case <-ctx.Done():
+			aborted = true
+
+			return false
+		default:
+		}
+		keysIterated++
+
+		address := hornet.Hash(key[:49])
+		change := rollback[string(address)]
+		delete(rollback, string(address))
+
+		return consume(address, int64(balanceFromBytes(value))-change)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if aborted {
+		return 0, ErrOperationAborted
+	}
+
+	// the addresses that are empty at the latest solid milestone are not part of its ledger state
+	if balanceErr == nil && !stopped {
+		for address, change := range rollback {
+			if !consume(hornet.Hash(address), -change) {
+				break
+			}
+		}
+	}
+
+	if balanceErr != nil {
+		return 0, balanceErr
+	}
+
+	// the supply can only be checked if the consumer didn't stop the iteration
+	if !stopped && total != consts.TotalSupply {
+		panic(fmt.Sprintf("total does not match supply: %d != %d", total, consts.TotalSupply))
+	}
+
+	return targetIndex, nil
+}
+
+// GetLedgerDiffBetweenMilestones returns the net balance changes per address from the ledger state
+// at the "from" milestone to the ledger state at the "to" milestone.
+// Addresses whose balance changes cancel each other out are not part of the result.
+// The diffs of the milestones in the range are composed, which is cheaper than subtracting the ledger states,
+// because the ledger state of a milestone is computed by rolling back the diffs from the latest solid milestone.
+// The result holds all changed addresses in memory, so callers have to limit the range.
+func (db *Database) GetLedgerDiffBetweenMilestones(ctx context.Context, fromIndex milestone.Index, toIndex milestone.Index) (map[string]int64, error) {
+	ctx, done := db.TrackMethod(ctx, "GetLedgerDiffBetweenMilestones", AttributeMilestoneIndex.Int64(int64(toIndex)))
+	resultCount := 0
+	defer func() { done(0, AttributeResultCount.Int(resultCount)) }()
+
+	if fromIndex > toIndex {
+		return nil, fmt.Errorf("from index is bigger than to index: %d > %d", fromIndex, toIndex)
+	}
+
+	if fromIndex < db.GetPruningIndex() {
+		return nil, fmt.Errorf("from index is too old. minimum: %d, actual: %d", db.GetPruningIndex(), fromIndex)
+	}
+
+	// the ledger state at "from" already contains the changes of the "from" milestone,
+	// so the diffs of the following milestones are composed. The keys are counted by the nested calls.
+	netDiff := make(map[string]int64)
+	for milestoneIndex := fromIndex + 1; milestoneIndex <= toIndex; milestoneIndex++ {
+		diff, err := db.GetLedgerDiffForMilestone(ctx, milestoneIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		for address, change := range diff {
+			netDiff[address] += change
+		}
+	}
+
+	var total int64
+	for address, change := range netDiff {
+		if change == 0 {
+			delete(netDiff, address)
+
+			continue
+		}
+		total += change
+	}
+
+	// every milestone only moves funds between addresses
+	if total != 0 {
+		return nil, fmt.Errorf("ledger diff between %d and %d doesn't sum to zero: %d", fromIndex, toIndex, total)
+	}
+
+	resultCount = len(netDiff)
+
+	return netDiff, nil
+}
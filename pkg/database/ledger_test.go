@@ -0,0 +1,146 @@
+package database_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/databasetest"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+// newLedgerDatabase returns a database with the milestones 1 to 3, whose ledger diffs move funds between the addresses A, B and C.
+// The address C is empty at the latest solid milestone, so it is only part of the ledger state of the first milestone.
+func newLedgerDatabase(t *testing.T) *database.Database {
+	t.Helper()
+
+	addressA := strings.Repeat("A", consts.HashTrytesSize)
+	addressB := strings.Repeat("B", consts.HashTrytesSize)
+	addressC := strings.Repeat("C", consts.HashTrytesSize)
+	addressD := strings.Repeat("D", consts.HashTrytesSize)
+
+	tangle := databasetest.New(t)
+	ms1 := tangle.AddMilestone(1, databasetest.NullHash, databasetest.NullHash)
+	ms2 := tangle.AddMilestone(2, ms1, ms1)
+	tangle.AddMilestone(3, ms2, ms2)
+	tangle.SetLedgerIndex(3)
+	tangle.SetBalance(addressA, 5)
+	tangle.SetBalance(addressB, 5)
+	tangle.SetBalance(addressD, consts.TotalSupply-10)
+	tangle.AddLedgerDiff(2, map[string]int64{addressA: 10, addressC: -10})
+	tangle.AddLedgerDiff(3, map[string]int64{addressA: -5, addressB: 5})
+
+	return tangle.Database()
+}
+
+func TestForEachBalanceForMilestone(t *testing.T) {
+	addressA := strings.Repeat("A", consts.HashTrytesSize)
+	addressB := strings.Repeat("B", consts.HashTrytesSize)
+	addressC := strings.Repeat("C", consts.HashTrytesSize)
+	addressD := strings.Repeat("D", consts.HashTrytesSize)
+
+	tests := []struct {
+		name        string
+		targetIndex milestone.Index
+		wantIndex   milestone.Index
+		want        map[string]uint64
+	}{
+		{
+			name:      "latest solid milestone",
+			wantIndex: 3,
+			want:      map[string]uint64{addressA: 5, addressB: 5, addressD: consts.TotalSupply - 10},
+		},
+		{
+			name:        "changes are rolled back",
+			targetIndex: 2,
+			wantIndex:   2,
+			want:        map[string]uint64{addressA: 10, addressD: consts.TotalSupply - 10},
+		},
+		{
+			name:        "addresses that are empty at the latest solid milestone",
+			targetIndex: 1,
+			wantIndex:   1,
+			want:        map[string]uint64{addressC: 10, addressD: consts.TotalSupply - 10},
+		},
+	}
+
+	db := newLedgerDatabase(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balances := make(map[string]uint64)
+			index, err := db.ForEachBalanceForMilestone(context.Background(), tt.targetIndex, func(address hornet.Hash, balance uint64) bool {
+				balances[address.Trytes()] = balance
+
+				return true
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if index != tt.wantIndex || !reflect.DeepEqual(balances, tt.want) {
+				t.Fatalf("got balances %v at %d, want %v at %d", balances, index, tt.want, tt.wantIndex)
+			}
+
+			// the streamed ledger state has to match the one that is composed in memory
+			state, _, err := db.GetLedgerStateForMilestone(context.Background(), tt.targetIndex)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for address, balance := range state {
+				if balances[hornet.Hash(address).Trytes()] != balance {
+					t.Fatalf("got balance %d for %s, the ledger state has %d", balances[hornet.Hash(address).Trytes()], hornet.Hash(address).Trytes(), balance)
+				}
+			}
+		})
+	}
+}
+
+func TestForEachBalanceForMilestoneStops(t *testing.T) {
+	db := newLedgerDatabase(t)
+
+	consumed := 0
+	if _, err := db.ForEachBalanceForMilestone(context.Background(), 1, func(hornet.Hash, uint64) bool {
+		consumed++
+
+		return false
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if consumed != 1 {
+		t.Fatalf("got %d consumed balances, want 1", consumed)
+	}
+}
+
+func TestForEachLedgerDiffChange(t *testing.T) {
+	db := newLedgerDatabase(t)
+
+	changes := make(map[string]int64)
+	if err := db.ForEachLedgerDiffChange(context.Background(), 2, func(address hornet.Hash, change int64) bool {
+		changes[string(address)] = change
+
+		return true
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := db.GetLedgerDiffForMilestone(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(changes, diff) {
+		t.Fatalf("got changes %v, want %v", changes, diff)
+	}
+
+	if err := db.ForEachLedgerDiffChange(context.Background(), 4, func(hornet.Hash, int64) bool { return true }); err == nil {
+		t.Fatal("got no error for a milestone above the latest solid milestone")
+	}
+}
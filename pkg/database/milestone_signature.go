@@ -0,0 +1,248 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/kerl"
+	"github.com/iotaledger/iota.go/merkle"
+	"github.com/iotaledger/iota.go/signing"
+	sponge "github.com/iotaledger/iota.go/signing/utils"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/curl"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+const (
+	// MilestoneMerkleHashFuncKerl is the name of the Kerl hash function for the coordinator Merkle tree.
+	MilestoneMerkleHashFuncKerl = "KERL"
+	// MilestoneMerkleHashFuncCurlP27 is the name of the Curl-P-27 hash function for the coordinator Merkle tree.
+	MilestoneMerkleHashFuncCurlP27 = "CURLP27"
+	// MilestoneMerkleHashFuncCurlP81 is the name of the Curl-P-81 hash function for the coordinator Merkle tree.
+	MilestoneMerkleHashFuncCurlP81 = "CURLP81"
+
+	// MaxMilestoneMerkleTreeDepth is the maximum depth of the Merkle tree of the coordinator.
+	MaxMilestoneMerkleTreeDepth = 32
+)
+
+// MilestoneMerkleHashFunc returns the creator of the hash function with the given name,
+// which the coordinator used to sign the milestones and to build its Merkle tree.
+func MilestoneMerkleHashFunc(name string) (sponge.SpongeFunctionCreator, error) {
+	switch strings.ToUpper(name) {
+	case MilestoneMerkleHashFuncKerl:
+		return func() sponge.SpongeFunction { return kerl.NewKerl() }, nil
+	case MilestoneMerkleHashFuncCurlP27:
+		return curl.NewCurlP27, nil
+	case MilestoneMerkleHashFuncCurlP81:
+		return curl.NewCurlP81, nil
+	default:
+		return nil, fmt.Errorf("unknown milestone merkle hash function: %s, supported: %s, %s, %s", name, MilestoneMerkleHashFuncKerl, MilestoneMerkleHashFuncCurlP27, MilestoneMerkleHashFuncCurlP81)
+	}
+}
+
+// MilestoneVerificationSettings are the parameters of the coordinator that are needed to verify milestone signatures.
+type MilestoneVerificationSettings struct {
+	// HashFunc is the hash function the coordinator used to sign the milestones and to build its Merkle tree.
+	HashFunc sponge.SpongeFunctionCreator
+	// MerkleTreeDepth is the depth of the Merkle tree of the coordinator.
+	MerkleTreeDepth int
+}
+
+// NewMilestoneVerificationSettings returns the settings for the hash function with the given name and the given Merkle tree depth.
+func NewMilestoneVerificationSettings(hashFuncName string, merkleTreeDepth int) (*MilestoneVerificationSettings, error) {
+	hashFunc, err := MilestoneMerkleHashFunc(hashFuncName)
+	if err != nil {
+		return nil, err
+	}
+
+	if merkleTreeDepth < 1 || merkleTreeDepth > MaxMilestoneMerkleTreeDepth {
+		return nil, fmt.Errorf("invalid milestone merkle tree depth: %d, must be between 1 and %d", merkleTreeDepth, MaxMilestoneMerkleTreeDepth)
+	}
+
+	return &MilestoneVerificationSettings{
+		HashFunc:        hashFunc,
+		MerkleTreeDepth: merkleTreeDepth,
+	}, nil
+}
+
+// MilestoneSignatureVerification is the result of the re-verification of the signature of a milestone bundle.
+type MilestoneSignatureVerification struct {
+	MilestoneIndex milestone.Index
+	// MilestoneHash is the hash of the tail transaction of the milestone bundle.
+	MilestoneHash hornet.Hash
+	// SecurityLevel is the security level of the signature, which is derived from the size of the bundle.
+	SecurityLevel int
+	// MerkleTreeDepth is the configured depth of the Merkle tree, which is the amount of siblings.
+	MerkleTreeDepth int
+	Valid           bool
+	// Error describes why the milestone is invalid.
+	Error string
+}
+
+// VerifyMilestoneSignatureOrNil re-verifies the Merkle signature of the milestone bundle with the given index
+// against the coordinator address of the snapshot. It returns nil if the milestone doesn't exist.
+// The signature is not trusted from the stored bundle metadata, but verified from the raw milestone transactions.
+func (db *Database) VerifyMilestoneSignatureOrNil(ctx context.Context, milestoneIndex milestone.Index, settings *MilestoneVerificationSettings) *MilestoneSignatureVerification {
+	_, done := db.TrackMethod(ctx, "VerifyMilestoneSignatureOrNil", AttributeMilestoneIndex.Int64(int64(milestoneIndex)))
+
+	verification, keysIterated := db.verifyMilestoneSignatureOrNil(milestoneIndex, settings)
+	done(keysIterated)
+
+	return verification
+}
+
+// VerifyMilestoneSignatures re-verifies the Merkle signatures of all milestones in the milestone store
+// from the first to the last index (inclusive). Missing milestones are skipped.
+func (db *Database) VerifyMilestoneSignatures(ctx context.Context, fromIndex milestone.Index, toIndex milestone.Index, settings *MilestoneVerificationSettings) ([]*MilestoneSignatureVerification, error) {
+	ctx, done := db.TrackMethod(ctx, "VerifyMilestoneSignatures", AttributeMilestoneIndex.Int64(int64(toIndex)))
+	keysIterated := 0
+	verifications := []*MilestoneSignatureVerification{}
+	defer func() { done(keysIterated, AttributeResultCount.Int(len(verifications))) }()
+
+	for milestoneIndex := fromIndex; milestoneIndex <= toIndex; milestoneIndex++ {
+		select {
+		case <-ctx.Done():
+			return nil, ErrOperationAborted
+		default:
+		}
+
+		verification, keys := db.verifyMilestoneSignatureOrNil(milestoneIndex, settings)
+		keysIterated += keys
+		if verification == nil {
+			continue
+		}
+		verifications = append(verifications, verification)
+	}
+
+	return verifications, nil
+}
+
+func (db *Database) verifyMilestoneSignatureOrNil(milestoneIndex milestone.Index, settings *MilestoneVerificationSettings) (*MilestoneSignatureVerification, int) {
+	bundle, keysIterated := db.getMilestoneBundleOrNil(milestoneIndex)
+	if bundle == nil {
+		return nil, keysIterated
+	}
+
+	txs := bundle.GetTransactions()
+	keysIterated += len(txs)
+
+	verification := &MilestoneSignatureVerification{
+		MilestoneIndex: milestoneIndex,
+		MilestoneHash:  bundle.GetMilestoneHash(),
+	}
+
+	if err := verifyMilestoneBundle(verification, txs, db.snapshot.CoordinatorAddress.Trytes(), settings); err != nil {
+		verification.Error = err.Error()
+
+		return verification, keysIterated
+	}
+	verification.Valid = true
+
+	return verification, keysIterated
+}
+
+// verifyMilestoneBundle checks the structure of the milestone bundle and its signature like the coordinator created it.
+// The first transactions of the bundle contain the signature fragments, one per security level.
+// The last transaction contains the siblings of the Merkle tree in its signature message fragment,
+// and its hash is the hash that was signed. The leaf index in the Merkle tree is the milestone index.
+func verifyMilestoneBundle(verification *MilestoneSignatureVerification, txs []*Transaction, coordinatorAddress trinary.Hash, settings *MilestoneVerificationSettings) error {
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Tx.CurrentIndex < txs[j].Tx.CurrentIndex })
+
+	for i, tx := range txs {
+		if tx.Tx.CurrentIndex != uint64(i) || tx.Tx.LastIndex != uint64(len(txs)-1) {
+			return fmt.Errorf("invalid bundle structure: transaction %s has index %d/%d in bundle of %d transactions", tx.Tx.Hash, tx.Tx.CurrentIndex, tx.Tx.LastIndex, len(txs))
+		}
+
+		if tx.Tx.Value != 0 {
+			return fmt.Errorf("milestone transaction %s has a value: %d", tx.Tx.Hash, tx.Tx.Value)
+		}
+	}
+
+	securityLevel := len(txs) - 1
+	if securityLevel < int(consts.SecurityLevelLow) || securityLevel > int(consts.SecurityLevelHigh) {
+		return fmt.Errorf("invalid security level: %d", securityLevel)
+	}
+	verification.SecurityLevel = securityLevel
+
+	tail := txs[0]
+	if tail.Tx.Address != coordinatorAddress {
+		return fmt.Errorf("milestone was not issued by the coordinator: %s", tail.Tx.Address)
+	}
+
+	if tagIndex := milestone.Index(trinary.TrytesToInt(tail.Tx.ObsoleteTag)); tagIndex != verification.MilestoneIndex {
+		return fmt.Errorf("milestone index in the tag does not match: %d", tagIndex)
+	}
+
+	// the siblings are followed by padding, the amount of siblings is the depth of the Merkle tree of the coordinator
+	depth := settings.MerkleTreeDepth
+	verification.MerkleTreeDepth = depth
+
+	siblingsTx := txs[securityLevel]
+	if len(siblingsTx.Tx.SignatureMessageFragment) < depth*consts.HashTrytesSize {
+		return fmt.Errorf("signature message fragment is too short for a merkle tree of depth %d", depth)
+	}
+
+	auditPath := make([]trinary.Hash, depth)
+	for i := 0; i < depth; i++ {
+		auditPath[i] = siblingsTx.Tx.SignatureMessageFragment[i*consts.HashTrytesSize : (i+1)*consts.HashTrytesSize]
+	}
+
+	if uint64(verification.MilestoneIndex) >= uint64(1)<<depth {
+		return fmt.Errorf("milestone index %d exceeds the merkle tree of depth %d", verification.MilestoneIndex, depth)
+	}
+
+	fragments := make([]trinary.Trytes, securityLevel)
+	for i := 0; i < securityLevel; i++ {
+		fragments[i] = txs[i].Tx.SignatureMessageFragment
+	}
+
+	address, err := milestoneSignatureAddress(fragments, siblingsTx.Tx.Hash, settings.HashFunc())
+	if err != nil {
+		return fmt.Errorf("failed to validate signature: %w", err)
+	}
+
+	root, err := merkle.MerkleRoot(address, uint32(verification.MilestoneIndex), auditPath, settings.HashFunc())
+	if err != nil {
+		return fmt.Errorf("failed to validate signature: %w", err)
+	}
+
+	if root != coordinatorAddress {
+		return fmt.Errorf("signature does not match the coordinator address: %s", coordinatorAddress)
+	}
+
+	return nil
+}
+
+// milestoneSignatureAddress computes the address of the Merkle tree leaf that signed the given hash.
+// Unlike signing.SignatureAddress, the 243rd trit of the signature segments is not ignored,
+// because it is only zero for signatures created with Kerl, but the coordinator may use Curl.
+func milestoneSignatureAddress(fragments []trinary.Trytes, hashToSign trinary.Hash, hashFunc sponge.SpongeFunction) (trinary.Hash, error) {
+	normalized := signing.NormalizedBundleHash(hashToSign)
+
+	digests := make(trinary.Trits, len(fragments)*consts.HashTrinarySize)
+	for i := range fragments {
+		fragmentTrits, err := trinary.TrytesToTrits(fragments[i])
+		if err != nil {
+			return "", err
+		}
+
+		normalizedFragment := normalized[i*consts.KeySegmentsPerFragment : (i+1)*consts.KeySegmentsPerFragment]
+		digest, err := signing.Digest(normalizedFragment, fragmentTrits, hashFunc)
+		if err != nil {
+			return "", err
+		}
+		copy(digests[i*consts.HashTrinarySize:], digest)
+	}
+
+	address, err := signing.Address(digests, hashFunc)
+	if err != nil {
+		return "", err
+	}
+
+	return trinary.TritsToTrytes(address)
+}
@@ -0,0 +1,304 @@
+package database
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/kerl"
+	"github.com/iotaledger/iota.go/signing"
+	"github.com/iotaledger/iota.go/signing/key"
+	sponge "github.com/iotaledger/iota.go/signing/utils"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+const (
+	testCoordinatorSecurityLevel = consts.SecurityLevelMedium
+	testCoordinatorDepth         = 3
+)
+
+// testCoordinator signs milestones like the coordinator with a Merkle tree built with the given hash function.
+type testCoordinator struct {
+	hashFunc sponge.SpongeFunctionCreator
+	// layers are the nodes of the Merkle tree, starting with the leaves.
+	layers [][]trinary.Hash
+}
+
+// testCoordinatorKey returns the private key of the given leaf of the Merkle tree.
+// The key is derived with Kerl and SHAKE256 independently of the hash function of the Merkle tree.
+func testCoordinatorKey(t *testing.T, leafIndex uint32) trinary.Trits {
+	t.Helper()
+
+	subseed, err := signing.Subseed(testSeed, uint64(leafIndex), kerl.NewKerl())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := key.Shake(subseed, testCoordinatorSecurityLevel)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return privateKey
+}
+
+func newTestCoordinator(t *testing.T, hashFunc sponge.SpongeFunctionCreator) *testCoordinator {
+	t.Helper()
+
+	leaves := make([]trinary.Hash, 1<<testCoordinatorDepth)
+	for i := range leaves {
+		h := hashFunc()
+
+		digests, err := signing.Digests(testCoordinatorKey(t, uint32(i)), h)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		address, err := signing.Address(digests, h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaves[i] = trinary.MustTritsToTrytes(address)
+	}
+
+	layers := [][]trinary.Hash{leaves}
+	for layer := leaves; len(layer) > 1; {
+		next := make([]trinary.Hash, len(layer)/2)
+		for i := range next {
+			h := hashFunc()
+			h.MustAbsorbTrytes(layer[2*i])
+			h.MustAbsorbTrytes(layer[2*i+1])
+			next[i] = h.MustSqueezeTrytes(consts.HashTrinarySize)
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+
+	return &testCoordinator{hashFunc: hashFunc, layers: layers}
+}
+
+func (c *testCoordinator) root() trinary.Hash {
+	return c.layers[len(c.layers)-1][0]
+}
+
+// milestoneBundle returns the transactions of a milestone bundle with the signature fragments
+// followed by the transaction with the siblings, whose hash is signed.
+func (c *testCoordinator) milestoneBundle(t *testing.T, index milestone.Index) []*Transaction {
+	t.Helper()
+
+	siblings := ""
+	for leafIndex, layer := uint32(index), 0; layer < testCoordinatorDepth; leafIndex, layer = leafIndex/2, layer+1 {
+		siblings += c.layers[layer][leafIndex^1]
+	}
+	siblings = trinary.MustPad(siblings, consts.SignatureMessageFragmentSizeInTrytes)
+
+	siblingsTxHash := strings.Repeat("S", consts.HashTrytesSize)
+	privateKey := testCoordinatorKey(t, uint32(index))
+	normalizedHash := signing.NormalizedBundleHash(siblingsTxHash)
+
+	fragments := make([]trinary.Trytes, testCoordinatorSecurityLevel)
+	for i := range fragments {
+		fragment, err := signing.SignatureFragment(
+			normalizedHash[i*consts.KeySegmentsPerFragment:(i+1)*consts.KeySegmentsPerFragment],
+			privateKey[i*consts.KeyFragmentLength:(i+1)*consts.KeyFragmentLength],
+			c.hashFunc(),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fragments[i] = trinary.MustTritsToTrytes(fragment)
+	}
+
+	lastIndex := uint64(len(fragments))
+	txs := make([]*Transaction, 0, len(fragments)+1)
+	for i, fragment := range fragments {
+		txs = append(txs, &Transaction{Tx: &transaction.Transaction{
+			Hash:                     strings.Repeat("T", consts.HashTrytesSize-1) + string(consts.TryteAlphabet[i+1]),
+			CurrentIndex:             uint64(i),
+			LastIndex:                lastIndex,
+			SignatureMessageFragment: fragment,
+		}})
+	}
+	txs[0].Tx.Address = c.root()
+	txs[0].Tx.ObsoleteTag = trinary.IntToTrytes(int64(index), consts.ObsoleteTagTrinarySize/3)
+
+	txs = append(txs, &Transaction{Tx: &transaction.Transaction{
+		Hash:                     siblingsTxHash,
+		CurrentIndex:             lastIndex,
+		LastIndex:                lastIndex,
+		SignatureMessageFragment: siblings,
+	}})
+
+	return txs
+}
+
+func TestVerifyMilestoneBundle(t *testing.T) {
+	const index milestone.Index = 5
+
+	curlP27Coordinator := newTestCoordinator(t, mustMilestoneMerkleHashFunc(t, MilestoneMerkleHashFuncCurlP27))
+	kerlCoordinator := newTestCoordinator(t, mustMilestoneMerkleHashFunc(t, MilestoneMerkleHashFuncKerl))
+
+	tests := []struct {
+		name        string
+		coordinator *testCoordinator
+		hashFunc    string
+		depth       int
+		index       milestone.Index
+		tamper      func(txs []*Transaction)
+		wantErr     string
+	}{
+		{
+			name:        "valid Curl-P-27 milestone",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP27,
+			depth:       testCoordinatorDepth,
+			index:       index,
+		},
+		{
+			name:        "valid Kerl milestone",
+			coordinator: kerlCoordinator,
+			hashFunc:    MilestoneMerkleHashFuncKerl,
+			depth:       testCoordinatorDepth,
+			index:       index,
+		},
+		{
+			name:        "wrong hash function",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP81,
+			depth:       testCoordinatorDepth,
+			index:       index,
+			wantErr:     "signature does not match",
+		},
+		{
+			name:        "wrong merkle tree depth",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP27,
+			depth:       testCoordinatorDepth + 1,
+			index:       index,
+			wantErr:     "signature does not match",
+		},
+		{
+			name:        "tampered signature",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP27,
+			depth:       testCoordinatorDepth,
+			index:       index,
+			tamper: func(txs []*Transaction) {
+				txs[1].Tx.SignatureMessageFragment = txs[0].Tx.SignatureMessageFragment
+			},
+			wantErr: "signature does not match",
+		},
+		{
+			name:        "wrong milestone index",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP27,
+			depth:       testCoordinatorDepth,
+			index:       index,
+			tamper: func(txs []*Transaction) {
+				txs[0].Tx.ObsoleteTag = trinary.IntToTrytes(int64(index+1), consts.ObsoleteTagTrinarySize/3)
+			},
+			wantErr: "milestone index in the tag does not match",
+		},
+		{
+			name:        "not issued by the coordinator",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP27,
+			depth:       testCoordinatorDepth,
+			index:       index,
+			tamper: func(txs []*Transaction) {
+				txs[0].Tx.Address = consts.NullHashTrytes
+			},
+			wantErr: "milestone was not issued by the coordinator",
+		},
+		{
+			name:        "value transaction",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP27,
+			depth:       testCoordinatorDepth,
+			index:       index,
+			tamper: func(txs []*Transaction) {
+				txs[1].Tx.Value = 1
+			},
+			wantErr: "has a value",
+		},
+		{
+			name:        "index exceeds the merkle tree",
+			coordinator: curlP27Coordinator,
+			hashFunc:    MilestoneMerkleHashFuncCurlP27,
+			depth:       testCoordinatorDepth - 1,
+			index:       index,
+			wantErr:     "exceeds the merkle tree",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := NewMilestoneVerificationSettings(tt.hashFunc, tt.depth)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			txs := tt.coordinator.milestoneBundle(t, tt.index)
+			if tt.tamper != nil {
+				tt.tamper(txs)
+			}
+
+			verification := &MilestoneSignatureVerification{MilestoneIndex: tt.index}
+			err = verifyMilestoneBundle(verification, txs, tt.coordinator.root(), settings)
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("got error %v, want none", err)
+				}
+
+				if verification.SecurityLevel != int(testCoordinatorSecurityLevel) || verification.MerkleTreeDepth != testCoordinatorDepth {
+					t.Fatalf("got security level %d and depth %d", verification.SecurityLevel, verification.MerkleTreeDepth)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewMilestoneVerificationSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		hashFunc string
+		depth    int
+		wantErr  bool
+	}{
+		{name: "curl p27", hashFunc: "CURLP27", depth: 24},
+		{name: "lower case", hashFunc: "kerl", depth: 1},
+		{name: "max depth", hashFunc: "CURLP81", depth: MaxMilestoneMerkleTreeDepth},
+		{name: "unknown hash function", hashFunc: "SHA3", depth: 24, wantErr: true},
+		{name: "depth zero", hashFunc: "CURLP27", depth: 0, wantErr: true},
+		{name: "depth too large", hashFunc: "CURLP27", depth: MaxMilestoneMerkleTreeDepth + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewMilestoneVerificationSettings(tt.hashFunc, tt.depth); (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func mustMilestoneMerkleHashFunc(t *testing.T, name string) sponge.SpongeFunctionCreator {
+	t.Helper()
+
+	hashFunc, err := MilestoneMerkleHashFunc(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return hashFunc
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 
@@ -34,7 +35,22 @@ type Milestone struct {
 	Hash  hornet.Hash
 }
 
+// GetMilestoneOrNil returns the milestone with the given index or nil if it doesn't exist.
 func (db *Database) GetMilestoneOrNil(milestoneIndex milestone.Index) *Milestone {
+	return db.GetMilestoneOrNilContext(context.Background(), milestoneIndex)
+}
+
+// GetMilestoneOrNilContext is like GetMilestoneOrNil, but traces the call in the span of the given context.
+func (db *Database) GetMilestoneOrNilContext(ctx context.Context, milestoneIndex milestone.Index) *Milestone {
+	_, done := db.trackLookup(ctx, "GetMilestoneOrNil", AttributeMilestoneIndex.Int64(int64(milestoneIndex)))
+
+	ms := db.getMilestoneOrNil(milestoneIndex)
+	done(keysFound(ms != nil))
+
+	return ms
+}
+
+func (db *Database) getMilestoneOrNil(milestoneIndex milestone.Index) *Milestone {
 	key := databaseKeyForMilestoneIndex(milestoneIndex)
 
 	data, err := db.milestoneStore.Get(key)
@@ -53,13 +69,29 @@ func (db *Database) GetMilestoneOrNil(milestoneIndex milestone.Index) *Milestone
 
 // GetMilestoneBundleOrNil returns the Bundle of a milestone index or nil if it doesn't exist.
 func (db *Database) GetMilestoneBundleOrNil(milestoneIndex milestone.Index) *Bundle {
+	return db.GetMilestoneBundleOrNilContext(context.Background(), milestoneIndex)
+}
 
-	milestone := db.GetMilestoneOrNil(milestoneIndex)
+// GetMilestoneBundleOrNilContext is like GetMilestoneBundleOrNil, but traces the call in the span of the given context.
+func (db *Database) GetMilestoneBundleOrNilContext(ctx context.Context, milestoneIndex milestone.Index) *Bundle {
+	_, done := db.TrackMethod(ctx, "GetMilestoneBundleOrNil", AttributeMilestoneIndex.Int64(int64(milestoneIndex)))
+
+	bundle, keysIterated := db.getMilestoneBundleOrNil(milestoneIndex)
+	done(keysIterated)
+
+	return bundle
+}
+
+func (db *Database) getMilestoneBundleOrNil(milestoneIndex milestone.Index) (*Bundle, int) {
+
+	milestone := db.getMilestoneOrNil(milestoneIndex)
 	if milestone == nil {
-		return nil
+		return nil, 0
 	}
 
-	return db.GetBundleOrNil(milestone.Hash)
+	bundle := db.getBundleOrNil(milestone.Hash)
+
+	return bundle, 1 + keysFound(bundle != nil)
 }
 
 func (db *Database) GetLedgerIndex() milestone.Index {
@@ -84,7 +116,7 @@ func (db *Database) GetSolidMilestoneIndex() milestone.Index {
 func (db *Database) GetLatestSolidMilestoneBundle() *Bundle {
 	db.latestSolidMilestoneBundleOnce.Do(func() {
 		latestSolidMilestoneIndex := db.GetSolidMilestoneIndex()
-		latestSolidMilestoneBundle := db.GetMilestoneBundleOrNil(latestSolidMilestoneIndex)
+		latestSolidMilestoneBundle, _ := db.getMilestoneBundleOrNil(latestSolidMilestoneIndex)
 		if latestSolidMilestoneBundle == nil {
 			panic(fmt.Errorf("latest solid milestone bundle not found: %d", latestSolidMilestoneIndex))
 		}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// valueContext keeps the values of its parent context, e.g. the span of the first caller,
+// but is neither canceled nor has a deadline.
+type valueContext struct {
+	context.Context
+}
+
+func (valueContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (valueContext) Done() <-chan struct{}       { return nil }
+func (valueContext) Err() error                  { return nil }
+
+// computation is a computation of a result that is in progress.
+type computation[V any] struct {
+	// done is closed after the value or the error was set.
+	done  chan struct{}
+	value V
+	err   error
+
+	// waiters is the amount of callers that still wait for the result.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// resultCacheEntry is a computed result in the eviction list of the cache.
+type resultCacheEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// resultCache caches the results of expensive computations, e.g. over the ledger state of a milestone, which never changes.
+// Concurrent callers of the same key share a single computation, which is done outside of the lock of the cache.
+// Every caller can abort waiting with its context. The computation is only canceled if all callers stopped waiting,
+// otherwise it continues, so the remaining callers don't have to start over.
+// The least recently used results are evicted if the cache exceeds its size.
+type resultCache[K comparable, V any] struct {
+	size int
+
+	lock         sync.Mutex
+	results      map[K]*list.Element
+	recentlyUsed *list.List
+	computations map[K]*computation[V]
+}
+
+// newResultCache returns a cache that keeps the results of the given amount of keys.
+func newResultCache[K comparable, V any](size int) *resultCache[K, V] {
+	return &resultCache[K, V]{
+		size:         size,
+		results:      make(map[K]*list.Element),
+		recentlyUsed: list.New(),
+		computations: make(map[K]*computation[V]),
+	}
+}
+
+// Get returns the cached result of the given key or waits for the computation of the result.
+// The computation is started with compute if it is not in progress yet. Errors are not cached.
+// It returns ErrOperationAborted if the context of the caller is done before the result is available.
+func (c *resultCache[K, V]) Get(ctx context.Context, key K, compute func(ctx context.Context) (V, error)) (V, error) {
+	c.lock.Lock()
+
+	if element, exists := c.results[key]; exists {
+		c.recentlyUsed.MoveToFront(element)
+		value := element.Value.(*resultCacheEntry[K, V]).value //nolint:forcetypeassert // only entries are stored in the list
+		c.lock.Unlock()
+
+		return value, nil
+	}
+
+	comp, exists := c.computations[key]
+	if !exists {
+		computeCtx, cancel := context.WithCancel(valueContext{Context: ctx})
+		comp = &computation[V]{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		c.computations[key] = comp
+
+		go c.compute(computeCtx, key, comp, compute)
+	}
+	comp.waiters++
+
+	c.lock.Unlock()
+
+	select {
+	case <-comp.done:
+		c.lock.Lock()
+		comp.waiters--
+		c.lock.Unlock()
+
+		return comp.value, comp.err
+
+	case <-ctx.Done():
+		c.lock.Lock()
+		comp.waiters--
+		if comp.waiters == 0 {
+			// nobody is interested in the result anymore, later callers start a new computation
+			comp.cancel()
+			if c.computations[key] == comp {
+				delete(c.computations, key)
+			}
+		}
+		c.lock.Unlock()
+
+		var empty V
+
+		return empty, ErrOperationAborted
+	}
+}
+
+func (c *resultCache[K, V]) compute(ctx context.Context, key K, comp *computation[V], compute func(ctx context.Context) (V, error)) {
+	defer comp.cancel()
+
+	value, err := compute(ctx)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	comp.value = value
+	comp.err = err
+	close(comp.done)
+	if c.computations[key] == comp {
+		delete(c.computations, key)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if element, exists := c.results[key]; exists {
+		// an abandoned computation finished after the computation that replaced it
+		c.recentlyUsed.MoveToFront(element)
+
+		return
+	}
+
+	c.results[key] = c.recentlyUsed.PushFront(&resultCacheEntry[K, V]{key: key, value: value})
+
+	if c.recentlyUsed.Len() > c.size {
+		oldest := c.recentlyUsed.Back()
+		c.recentlyUsed.Remove(oldest)
+		delete(c.results, oldest.Value.(*resultCacheEntry[K, V]).key) //nolint:forcetypeassert // only entries are stored in the list
+	}
+}
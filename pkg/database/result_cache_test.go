@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResultCacheEviction(t *testing.T) {
+	tests := []struct {
+		name string
+		// gets are the keys that are requested in order.
+		gets         []int
+		wantComputed []int
+	}{
+		{
+			name:         "cached results are not computed again",
+			gets:         []int{1, 2, 1, 2},
+			wantComputed: []int{1, 2},
+		},
+		{
+			name:         "least recently used result is evicted",
+			gets:         []int{1, 2, 1, 3, 1, 2},
+			wantComputed: []int{1, 2, 3, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newResultCache[int, int](2)
+
+			computed := []int{}
+			for _, key := range tt.gets {
+				value, err := c.Get(context.Background(), key, func(ctx context.Context) (int, error) {
+					computed = append(computed, key)
+
+					return key * 10, nil
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if value != key*10 {
+					t.Fatalf("got value %d for key %d", value, key)
+				}
+			}
+
+			if len(computed) != len(tt.wantComputed) {
+				t.Fatalf("got computed keys %v, want %v", computed, tt.wantComputed)
+			}
+			for i := range computed {
+				if computed[i] != tt.wantComputed[i] {
+					t.Fatalf("got computed keys %v, want %v", computed, tt.wantComputed)
+				}
+			}
+		})
+	}
+}
+
+func TestResultCacheErrorsAreNotCached(t *testing.T) {
+	c := newResultCache[int, int](2)
+	errCompute := errors.New("compute failed")
+
+	if _, err := c.Get(context.Background(), 1, func(ctx context.Context) (int, error) { return 0, errCompute }); !errors.Is(err, errCompute) {
+		t.Fatalf("got error %v, want %v", err, errCompute)
+	}
+
+	value, err := c.Get(context.Background(), 1, func(ctx context.Context) (int, error) { return 10, nil })
+	if err != nil || value != 10 {
+		t.Fatalf("got value %d and error %v, want 10", value, err)
+	}
+}
+
+func TestResultCacheSharedComputation(t *testing.T) {
+	tests := []struct {
+		name string
+		// abortFirst aborts the caller that started the computation.
+		abortFirst bool
+		// abortSecond aborts the caller that joined the computation.
+		abortSecond      bool
+		wantCanceled     bool
+		wantSecondErr    error
+		wantComputations int
+	}{
+		{
+			name:             "callers share the computation",
+			wantComputations: 1,
+		},
+		{
+			name:             "computation continues if the first caller aborts",
+			abortFirst:       true,
+			wantComputations: 1,
+		},
+		{
+			name:             "computation is canceled if all callers abort",
+			abortFirst:       true,
+			abortSecond:      true,
+			wantCanceled:     true,
+			wantSecondErr:    ErrOperationAborted,
+			wantComputations: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newResultCache[int, int](2)
+
+			started := make(chan struct{})
+			release := make(chan struct{})
+			canceled := make(chan struct{})
+			computations := 0
+			compute := func(ctx context.Context) (int, error) {
+				computations++
+				close(started)
+
+				select {
+				case <-release:
+					return 10, nil
+				case <-ctx.Done():
+					close(canceled)
+
+					return 0, ErrOperationAborted
+				}
+			}
+
+			firstCtx, abortFirst := context.WithCancel(context.Background())
+			defer abortFirst()
+			firstErr := make(chan error, 1)
+			go func() {
+				_, err := c.Get(firstCtx, 1, compute)
+				firstErr <- err
+			}()
+			<-started
+
+			secondCtx, abortSecond := context.WithCancel(context.Background())
+			defer abortSecond()
+			secondErr := make(chan error, 1)
+			go func() {
+				_, err := c.Get(secondCtx, 1, compute)
+				secondErr <- err
+			}()
+			waitForWaiters(t, c, 1, 2)
+
+			if tt.abortFirst {
+				abortFirst()
+				if err := <-firstErr; !errors.Is(err, ErrOperationAborted) {
+					t.Fatalf("got error %v for the first caller, want %v", err, ErrOperationAborted)
+				}
+			}
+
+			if tt.abortSecond {
+				abortSecond()
+			}
+
+			if tt.wantCanceled {
+				select {
+				case <-canceled:
+				case <-time.After(time.Second):
+					t.Fatal("computation was not canceled")
+				}
+			} else {
+				close(release)
+			}
+
+			if err := <-secondErr; !errors.Is(err, tt.wantSecondErr) {
+				t.Fatalf("got error %v for the second caller, want %v", err, tt.wantSecondErr)
+			}
+
+			if computations != tt.wantComputations {
+				t.Fatalf("got %d computations, want %d", computations, tt.wantComputations)
+			}
+		})
+	}
+}
+
+// waitForWaiters waits until the given amount of callers wait for the computation of the key.
+func waitForWaiters(t *testing.T, c *resultCache[int, int], key int, waiters int) {
+	t.Helper()
+
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		c.lock.Lock()
+		comp, exists := c.computations[key]
+		current := 0
+		if exists {
+			current = comp.waiters
+		}
+		c.lock.Unlock()
+
+		if current == waiters {
+			return
+		}
+	}
+
+	t.Fatalf("callers didn't wait for the computation of key %d", key)
+}
@@ -86,3 +86,14 @@ func snapshotInfoFromBytes(bytes []byte) (*SnapshotInfo, error) {
 func (i *SnapshotInfo) IsSpentAddressesEnabled() bool {
 	return i.Metadata.HasBit(SnapshotMetadataSpentAddressesEnabled)
 }
+
+// GetPruningIndex returns the index of the last pruned milestone.
+// The ledger diffs of the milestones above it up to the ledger index are available.
+func (db *Database) GetPruningIndex() milestone.Index {
+	return db.snapshot.PruningIndex
+}
+
+// IsSpentAddressesEnabled returns whether the spent addresses were tracked by the node that created the database.
+func (db *Database) IsSpentAddressesEnabled() bool {
+	return db.snapshot.IsSpentAddressesEnabled()
+}
@@ -1,10 +1,136 @@
 package database
 
 import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/hive.go/core/generics/lo"
+	"github.com/iotaledger/hive.go/core/kvstore"
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
 
+var (
+	// ErrSpentAddressesDisabled is returned if the node that created the database didn't track the spent addresses.
+	ErrSpentAddressesDisabled = errors.New("spent addresses are not tracked by the database")
+)
+
+// AddressBalance is the balance of an address at the ledger index.
+type AddressBalance struct {
+	Address hornet.Hash
+	Balance uint64
+}
+
+// WasAddressSpentFrom returns whether the given address was spent from.
 func (db *Database) WasAddressSpentFrom(address hornet.Hash) bool {
-	return lo.PanicOnErr(db.spentAddressesStore.Has(address[:49]))
+	return db.WasAddressSpentFromContext(context.Background(), address)
+}
+
+// WasAddressSpentFromContext is like WasAddressSpentFrom, but traces the call in the span of the given context.
+func (db *Database) WasAddressSpentFromContext(ctx context.Context, address hornet.Hash) bool {
+	_, done := db.trackLookup(ctx, "WasAddressSpentFrom")
+
+	spent := lo.PanicOnErr(db.spentAddressesStore.Has(address[:49]))
+	done(keysFound(spent))
+
+	return spent
+}
+
+// fundsOnSpentAddresses are all spent addresses with a non-zero balance at the ledger index.
+type fundsOnSpentAddresses struct {
+	funds []*AddressBalance
+	total uint64
+}
+
+// GetFundsOnSpentAddresses returns all spent addresses with a non-zero balance at the ledger index,
+// ordered by address, and the sum of their balances.
+// The result is only computed once, since the ledger of the database doesn't change.
+// Concurrent callers share a single scan, which is only aborted if all of them stopped waiting.
+// It returns ErrSpentAddressesDisabled if the spent addresses weren't tracked.
+func (db *Database) GetFundsOnSpentAddresses(ctx context.Context) ([]*AddressBalance, uint64, error) {
+	ctx, done := db.TrackMethod(ctx, "GetFundsOnSpentAddresses")
+	resultCount := 0
+	// the keys are counted by the scan
+	defer func() { done(0, AttributeResultCount.Int(resultCount)) }()
+
+	if !db.IsSpentAddressesEnabled() {
+		return nil, 0, ErrSpentAddressesDisabled
+	}
+
+	result, err := db.fundsOnSpentAddresses.Get(ctx, struct{}{}, db.scanFundsOnSpentAddresses)
+	if err != nil {
+		return nil, 0, err
+	}
+	resultCount = len(result.funds)
+
+	return result.funds, result.total, nil
+}
+
+// scanFundsOnSpentAddresses iterates over the balances of the ledger and checks for every address whether it was spent from.
+func (db *Database) scanFundsOnSpentAddresses(ctx context.Context) (*fundsOnSpentAddresses, error) {
+	ctx, done := db.TrackMethod(ctx, "ScanFundsOnSpentAddresses")
+	keysIterated := 0
+	defer func() { done(keysIterated) }()
+
+	result := &fundsOnSpentAddresses{
+		funds: make([]*AddressBalance, 0),
+	}
+
+	aborted := false
+	var spentErr error
+	err := db.ledgerBalanceStore.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		select {
+		case <-ctx.Done():
+			aborted = true
+
+			return false
+		default:
+		}
+		keysIterated++
+
+		balance := balanceFromBytes(value)
+		if balance == 0 {
+			return true
+		}
+
+		spent, err := db.spentAddressesStore.Has(key[:49])
+		if err != nil {
+			spentErr = err
+
+			return false
+		}
+		keysIterated++
+
+		if !spent {
+			return true
+		}
+
+		result.funds = append(result.funds, &AddressBalance{
+			Address: hornet.Hash(key[:49]),
+			Balance: balance,
+		})
+		result.total += balance
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if spentErr != nil {
+		return nil, spentErr
+	}
+
+	if aborted {
+		return nil, ErrOperationAborted
+	}
+
+	// the pagination relies on the order of the addresses
+	sort.Slice(result.funds, func(i, j int) bool {
+		return bytes.Compare(result.funds[i].Address, result.funds[j].Address) < 0
+	})
+
+	return result, nil
 }
@@ -0,0 +1,68 @@
+package database_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/databasetest"
+)
+
+func TestGetFundsOnSpentAddresses(t *testing.T) {
+	addressA := strings.Repeat("A", consts.HashTrytesSize)
+	addressB := strings.Repeat("B", consts.HashTrytesSize)
+	addressC := strings.Repeat("C", consts.HashTrytesSize)
+	addressD := strings.Repeat("D", consts.HashTrytesSize)
+
+	tangle := databasetest.New(t)
+	tangle.SetSnapshot(0, true)
+	tangle.SetBalance(addressA, 10)
+	tangle.SetBalance(addressB, 0)
+	tangle.SetBalance(addressC, 20)
+	tangle.SetBalance(addressD, 5)
+	tangle.AddSpentAddress(addressA)
+	tangle.AddSpentAddress(addressB)
+	tangle.AddSpentAddress(addressD)
+	db := tangle.Database()
+
+	funds, total, err := db.GetFundsOnSpentAddresses(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// addresses without funds and unspent addresses are skipped
+	if len(funds) != 2 || total != 15 {
+		t.Fatalf("got %d addresses with %d funds, want 2 addresses with 15 funds", len(funds), total)
+	}
+
+	for i := 1; i < len(funds); i++ {
+		if bytes.Compare(funds[i-1].Address, funds[i].Address) >= 0 {
+			t.Fatal("funds are not ordered by address")
+		}
+	}
+
+	// the ledger of the database doesn't change, so the result of the scan is reused
+	tangle.AddSpentAddress(addressC)
+
+	funds, total, err = db.GetFundsOnSpentAddresses(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(funds) != 2 || total != 15 {
+		t.Fatalf("got %d addresses with %d funds, want the cached 2 addresses with 15 funds", len(funds), total)
+	}
+}
+
+func TestGetFundsOnSpentAddressesDisabled(t *testing.T) {
+	db := databasetest.New(t).Database()
+
+	if _, _, err := db.GetFundsOnSpentAddresses(context.Background()); !errors.Is(err, database.ErrSpentAddressesDisabled) {
+		t.Fatalf("got error %v, want %v", err, database.ErrSpentAddressesDisabled)
+	}
+}
@@ -1,13 +1,24 @@
 package database
 
 import (
+	"context"
+
 	"github.com/iotaledger/hive.go/core/generics/lo"
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
 
+// GetTagHashes returns the hashes of the transactions with the given tag.
 func (db *Database) GetTagHashes(txTag hornet.Hash, forceRelease bool, maxFind ...int) hornet.Hashes {
+	return db.GetTagHashesContext(context.Background(), txTag, forceRelease, maxFind...)
+}
+
+// GetTagHashesContext is like GetTagHashes, but traces the call in the span of the given context.
+func (db *Database) GetTagHashesContext(ctx context.Context, txTag hornet.Hash, forceRelease bool, maxFind ...int) hornet.Hashes {
 	var tagHashes hornet.Hashes
 
+	_, done := db.TrackMethod(ctx, "GetTagHashes")
+	defer func() { done(len(tagHashes)) }()
+
 	i := 0
 	_ = db.tagsStore.IterateKeys(txTag, func(key []byte) bool {
 		i++
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -121,7 +122,22 @@ func metadataFactory(key []byte, data []byte) (*TransactionMetadata, error) {
 	return txMeta, nil
 }
 
+// GetTransactionOrNil returns the transaction with the given hash or nil if it doesn't exist.
 func (db *Database) GetTransactionOrNil(txHash hornet.Hash) *Transaction {
+	return db.GetTransactionOrNilContext(context.Background(), txHash)
+}
+
+// GetTransactionOrNilContext is like GetTransactionOrNil, but traces the call in the span of the given context.
+func (db *Database) GetTransactionOrNilContext(ctx context.Context, txHash hornet.Hash) *Transaction {
+	_, done := db.trackLookup(ctx, "GetTransactionOrNil")
+
+	tx := db.getTransactionOrNil(txHash)
+	done(keysFound(tx != nil))
+
+	return tx
+}
+
+func (db *Database) getTransactionOrNil(txHash hornet.Hash) *Transaction {
 	key := txHash
 
 	data, err := db.txStore.Get(key)
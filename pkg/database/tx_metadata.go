@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 
@@ -112,7 +113,22 @@ func (m *TransactionMetadata) Unmarshal(data []byte) error {
 	return nil
 }
 
+// GetTxMetadataOrNil returns the metadata of the transaction with the given hash or nil if it doesn't exist.
 func (db *Database) GetTxMetadataOrNil(txHash hornet.Hash) *TransactionMetadata {
+	return db.GetTxMetadataOrNilContext(context.Background(), txHash)
+}
+
+// GetTxMetadataOrNilContext is like GetTxMetadataOrNil, but traces the call in the span of the given context.
+func (db *Database) GetTxMetadataOrNilContext(ctx context.Context, txHash hornet.Hash) *TransactionMetadata {
+	_, done := db.trackLookup(ctx, "GetTxMetadataOrNil")
+
+	txMeta := db.getTxMetadataOrNil(txHash)
+	done(keysFound(txMeta != nil))
+
+	return txMeta
+}
+
+func (db *Database) getTxMetadataOrNil(txHash hornet.Hash) *TransactionMetadata {
 	key := txHash
 
 	data, err := db.metadataStore.Get(key)
@@ -139,7 +155,7 @@ func (db *Database) addAdditionalTxInfoToMetadata(metadata *TransactionMetadata)
 	branchHash := metadata.GetTrunkHash()
 
 	if len(trunkHash) == 0 || len(branchHash) == 0 {
-		tx := db.GetTransactionOrNil(metadata.GetTxHash())
+		tx := db.getTransactionOrNil(metadata.GetTxHash())
 		if tx == nil {
 			panic(fmt.Sprintf("transaction not found for metadata: %v", metadata.GetTxHash().Trytes()))
 		}
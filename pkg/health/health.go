@@ -0,0 +1,131 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// isHealthyMaxAge is the duration the result of IsHealthy is reused,
+	// so frequent requests of the node info don't run all checks.
+	isHealthyMaxAge = 5 * time.Second
+)
+
+// Check returns an error if the checked component is not healthy.
+type Check func(ctx context.Context) error
+
+// Result is the result of a single health check.
+type Result struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Healthy tells whether the check succeeded.
+	Healthy bool `json:"healthy"`
+	// Error is the reason why the check failed.
+	Error string `json:"error,omitempty"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs the registered liveness and readiness checks.
+type Checker struct {
+	checksLock      sync.RWMutex
+	livenessChecks  []*namedCheck
+	readinessChecks []*namedCheck
+
+	// the last result of IsHealthy
+	healthyLock      sync.Mutex
+	healthy          bool
+	healthyCheckedAt time.Time
+	healthyChecking  bool
+}
+
+// New creates a new Checker without any checks.
+func New() *Checker {
+	return &Checker{}
+}
+
+// AddLivenessCheck adds a check that fails if the application needs to be restarted.
+// Liveness checks are part of the readiness checks as well.
+func (h *Checker) AddLivenessCheck(name string, check Check) {
+	h.checksLock.Lock()
+	defer h.checksLock.Unlock()
+
+	h.livenessChecks = append(h.livenessChecks, &namedCheck{name: name, check: check})
+}
+
+// AddReadinessCheck adds a check that fails if the application is not able to serve requests.
+func (h *Checker) AddReadinessCheck(name string, check Check) {
+	h.checksLock.Lock()
+	defer h.checksLock.Unlock()
+
+	h.readinessChecks = append(h.readinessChecks, &namedCheck{name: name, check: check})
+}
+
+// Live runs the liveness checks and returns their results and whether all of them succeeded.
+func (h *Checker) Live(ctx context.Context) ([]*Result, bool) {
+	h.checksLock.RLock()
+	checks := h.livenessChecks
+	h.checksLock.RUnlock()
+
+	return run(ctx, checks)
+}
+
+// Ready runs the liveness and readiness checks and returns their results and whether all of them succeeded.
+func (h *Checker) Ready(ctx context.Context) ([]*Result, bool) {
+	h.checksLock.RLock()
+	checks := make([]*namedCheck, 0, len(h.livenessChecks)+len(h.readinessChecks))
+	checks = append(checks, h.livenessChecks...)
+	checks = append(checks, h.readinessChecks...)
+	h.checksLock.RUnlock()
+
+	return run(ctx, checks)
+}
+
+// IsHealthy checks whether all liveness and readiness checks succeed.
+// The result is reused for a short time. While the checks are run again, other callers get the last result.
+func (h *Checker) IsHealthy(ctx context.Context) bool {
+	h.healthyLock.Lock()
+	if !h.healthyCheckedAt.IsZero() && (h.healthyChecking || time.Since(h.healthyCheckedAt) < isHealthyMaxAge) {
+		healthy := h.healthy
+		h.healthyLock.Unlock()
+
+		return healthy
+	}
+	h.healthyChecking = true
+	h.healthyLock.Unlock()
+
+	_, healthy := h.Ready(ctx)
+
+	h.healthyLock.Lock()
+	defer h.healthyLock.Unlock()
+
+	h.healthyChecking = false
+	// the checks of a canceled request may have failed because of the request
+	if ctx.Err() == nil {
+		h.healthy = healthy
+		h.healthyCheckedAt = time.Now()
+	}
+
+	return healthy
+}
+
+func run(ctx context.Context, checks []*namedCheck) ([]*Result, bool) {
+	results := make([]*Result, 0, len(checks))
+	healthy := true
+
+	for _, c := range checks {
+		result := &Result{Name: c.name, Healthy: true}
+		if err := c.check(ctx); err != nil {
+			result.Healthy = false
+			result.Error = err.Error()
+			healthy = false
+		}
+		results = append(results, result)
+	}
+
+	return results, healthy
+}
@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReady(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+
+	tests := []struct {
+		name           string
+		livenessErr    error
+		readinessErr   error
+		wantLive       bool
+		wantReady      bool
+		wantReadyCount int
+	}{
+		{name: "healthy", wantLive: true, wantReady: true, wantReadyCount: 2},
+		{name: "not ready", readinessErr: errUnhealthy, wantLive: true, wantReadyCount: 2},
+		{name: "not live", livenessErr: errUnhealthy, wantReadyCount: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New()
+			h.AddLivenessCheck("live", func(ctx context.Context) error { return tt.livenessErr })
+			h.AddReadinessCheck("ready", func(ctx context.Context) error { return tt.readinessErr })
+
+			if _, live := h.Live(context.Background()); live != tt.wantLive {
+				t.Fatalf("got live %t, want %t", live, tt.wantLive)
+			}
+
+			results, ready := h.Ready(context.Background())
+			if ready != tt.wantReady {
+				t.Fatalf("got ready %t, want %t", ready, tt.wantReady)
+			}
+
+			if len(results) != tt.wantReadyCount {
+				t.Fatalf("got %d results, want %d", len(results), tt.wantReadyCount)
+			}
+		})
+	}
+}
+
+func TestIsHealthyReusesResult(t *testing.T) {
+	tests := []struct {
+		name string
+		// expire marks the last result as outdated before the second call.
+		expire bool
+		// cancelFirst cancels the context of the first call.
+		cancelFirst bool
+		wantRuns    int
+		wantHealthy bool
+	}{
+		{name: "result is reused", wantRuns: 1, wantHealthy: false},
+		{name: "outdated result is checked again", expire: true, wantRuns: 2, wantHealthy: true},
+		{name: "result of a canceled call is not reused", cancelFirst: true, wantRuns: 2, wantHealthy: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs := 0
+			h := New()
+			h.AddReadinessCheck("ready", func(ctx context.Context) error {
+				runs++
+				// only the first run fails
+				if runs == 1 {
+					return errors.New("not ready yet")
+				}
+
+				return nil
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancelFirst {
+				cancel()
+			}
+			defer cancel()
+
+			if h.IsHealthy(ctx) {
+				t.Fatal("first call is healthy")
+			}
+
+			if tt.expire {
+				h.healthyCheckedAt = time.Now().Add(-isHealthyMaxAge)
+			}
+
+			if healthy := h.IsHealthy(context.Background()); healthy != tt.wantHealthy {
+				t.Fatalf("got healthy %t, want %t", healthy, tt.wantHealthy)
+			}
+
+			if runs != tt.wantRuns {
+				t.Fatalf("got %d runs of the checks, want %d", runs, tt.wantRuns)
+			}
+		})
+	}
+}
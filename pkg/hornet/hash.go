@@ -14,7 +14,7 @@ var (
 )
 
 const (
-	addressTrytesSize = hashTrytesSize + consts.AddressChecksumTrytesSize/consts.TritsPerTryte
+	addressTrytesSize = consts.AddressWithChecksumTrytesSize
 	hashTrytesSize    = consts.HashTrytesSize
 	tagTrytesSize     = consts.TagTrinarySize / consts.TritsPerTryte
 )
@@ -0,0 +1,87 @@
+package hornet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+)
+
+func TestHashFromAddressTrytes(t *testing.T) {
+	address := strings.Repeat("A", consts.HashTrytesSize)
+
+	tests := []struct {
+		name      string
+		trytes    string
+		wantPanic bool
+	}{
+		{name: "81 trytes", trytes: address},
+		{name: "90 trytes", trytes: address + "YLFHUOJUY"},
+		{name: "too short", trytes: address[1:], wantPanic: true},
+		{name: "between 81 and 90 trytes", trytes: address + "YLFH", wantPanic: true},
+		{name: "too long", trytes: address + "YLFHUOJUY9", wantPanic: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Fatalf("got panic %v, want panic: %t", r, tt.wantPanic)
+				}
+			}()
+
+			hash := HashFromAddressTrytes(tt.trytes)
+
+			// the checksum is dropped, so both lengths have the same binary representation
+			if len(hash) != hashBytesSize || hash.Trytes() != address {
+				t.Fatalf("got address %s, want %s", hash.Trytes(), address)
+			}
+		})
+	}
+}
+
+func TestHashTrytes(t *testing.T) {
+	txHash := strings.Repeat("9", consts.HashTrytesSize-1) + "Z"
+	tag := strings.Repeat("9", consts.TagTrinarySize/consts.TritsPerTryte-1) + "Z"
+
+	tests := []struct {
+		name      string
+		hash      func() Hash
+		want      string
+		wantPanic bool
+	}{
+		{name: "hash", hash: func() Hash { return HashFromHashTrytes(txHash) }, want: txHash},
+		{name: "tag", hash: func() Hash { return HashFromTagTrytes(tag) }, want: tag},
+		{name: "hash of invalid length", hash: func() Hash { return HashFromHashTrytes(tag) }, wantPanic: true},
+		{name: "tag of invalid length", hash: func() Hash { return HashFromTagTrytes(txHash) }, wantPanic: true},
+		{name: "binary hash of invalid length", hash: func() Hash { return HashFromHashTrytes(txHash)[1:] }, wantPanic: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Fatalf("got panic %v, want panic: %t", r, tt.wantPanic)
+				}
+			}()
+
+			if got := tt.hash().Trytes(); got != tt.want {
+				t.Fatalf("got trytes %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashBinaryRepresentation(t *testing.T) {
+	hashA := HashFromHashTrytes(strings.Repeat("A", consts.HashTrytesSize))
+	hashB := HashFromHashTrytes(strings.Repeat("B", consts.HashTrytesSize))
+
+	if !bytes.Equal(hashA, HashFromAddressTrytes(strings.Repeat("A", consts.HashTrytesSize))) {
+		t.Fatal("got different binary representations of the same hash and address trytes")
+	}
+
+	if bytes.Equal(hashA, hashB) {
+		t.Fatal("got the same binary representation for different hashes")
+	}
+}
@@ -0,0 +1,149 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+
+	gzipScheme = "gzip"
+)
+
+// KeyFunc returns a key that identifies the response of an immutable request.
+// An empty key means the response of the request may change and must not be cached.
+type KeyFunc func(c echo.Context) string
+
+// Cache sets caching headers for immutable responses and answers conditional requests.
+type Cache struct {
+	keyFunc      KeyFunc
+	version      string
+	cacheControl string
+}
+
+// New creates a new Cache that allows clients and proxies to cache immutable responses for the given duration.
+// The version of the application is part of the ETags, so cached responses are revalidated after an update,
+// which may change the representation of the responses.
+func New(keyFunc KeyFunc, version string, maxAge time.Duration) *Cache {
+	return &Cache{
+		keyFunc:      keyFunc,
+		version:      version,
+		cacheControl: fmt.Sprintf("public, max-age=%d, immutable", int64(maxAge.Seconds())),
+	}
+}
+
+// etag returns the strong ETag of the response of the request with the given key.
+func (ca *Cache) etag(c echo.Context, key string) string {
+	hash := sha256.Sum256([]byte(ca.version + "\x00" + key))
+	tag := hex.EncodeToString(hash[:16])
+
+	// the compressed and the uncompressed response are different representations
+	if strings.Contains(c.Request().Header.Get(echo.HeaderAcceptEncoding), gzipScheme) {
+		tag += "-" + gzipScheme
+	}
+
+	return `"` + tag + `"`
+}
+
+// matchesETag checks whether the If-None-Match header of the request contains the given ETag.
+// The second return value is true if the header contains the wildcard, which matches any existing representation.
+func matchesETag(c echo.Context, tag string) (bool, bool) {
+	ifNoneMatch := c.Request().Header.Get(headerIfNoneMatch)
+	if ifNoneMatch == "" {
+		return false, false
+	}
+
+	wildcard := false
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			wildcard = true
+
+			continue
+		}
+
+		// If-None-Match uses the weak comparison
+		if strings.TrimPrefix(candidate, "W/") == tag {
+			return true, false
+		}
+	}
+
+	return false, wildcard
+}
+
+// notModifiedWriter answers with "304 Not Modified" instead of a successful response and discards its body.
+// Other responses are passed through, since the wildcard only matches existing representations.
+type notModifiedWriter struct {
+	http.ResponseWriter
+	notModified bool
+}
+
+func (w *notModifiedWriter) WriteHeader(statusCode int) {
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		w.ResponseWriter.WriteHeader(statusCode)
+
+		return
+	}
+
+	w.notModified = true
+	w.Header().Del(echo.HeaderContentType)
+	w.Header().Del(echo.HeaderContentLength)
+	w.ResponseWriter.WriteHeader(http.StatusNotModified)
+}
+
+func (w *notModifiedWriter) Write(b []byte) (int, error) {
+	if w.notModified {
+		return len(b), nil
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Middleware returns an echo middleware that sets ETag and Cache-Control headers for immutable responses
+// and answers requests with a matching If-None-Match header with "304 Not Modified".
+func (ca *Cache) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := ca.keyFunc(c)
+			if key == "" {
+				return next(c)
+			}
+
+			tag := ca.etag(c, key)
+
+			header := c.Response().Header()
+			header.Set(headerETag, tag)
+			header.Set(echo.HeaderCacheControl, ca.cacheControl)
+
+			matches, wildcard := matchesETag(c, tag)
+			if matches {
+				return c.NoContent(http.StatusNotModified)
+			}
+
+			if wildcard {
+				// the handler decides whether the representation exists
+				writer := &notModifiedWriter{ResponseWriter: c.Response().Writer}
+				c.Response().Writer = writer
+				defer func() { c.Response().Writer = writer.ResponseWriter }()
+			}
+
+			if err := next(c); err != nil {
+				// error responses must not be cached
+				header.Del(headerETag)
+				header.Del(echo.HeaderCacheControl)
+
+				return err
+			}
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package httpcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const testVersion = "1.0.0"
+
+// newTestEcho returns an echo instance with the cache, which serves "/found" and "/missing".
+func newTestEcho(version string) *echo.Echo {
+	cache := New(func(c echo.Context) string { return c.Path() }, version, time.Minute)
+
+	e := echo.New()
+	e.Use(cache.Middleware())
+	e.GET("/found", func(c echo.Context) error { return c.String(http.StatusOK, "found") })
+	e.GET("/missing", func(c echo.Context) error { return echo.ErrNotFound })
+
+	return e
+}
+
+// testETag returns the ETag of the given path that is created by the cache with the given version.
+func testETag(t *testing.T, version string, path string) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	newTestEcho(version).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	tag := rec.Header().Get(headerETag)
+	if tag == "" {
+		t.Fatalf("got no ETag for %s", path)
+	}
+
+	return tag
+}
+
+func TestMiddleware(t *testing.T) {
+	tag := testETag(t, testVersion, "/found")
+
+	tests := []struct {
+		name        string
+		path        string
+		ifNoneMatch string
+		wantStatus  int
+		wantBody    string
+		wantETag    bool
+	}{
+		{
+			name:       "unconditional request",
+			path:       "/found",
+			wantStatus: http.StatusOK,
+			wantBody:   "found",
+			wantETag:   true,
+		},
+		{
+			name:        "matching ETag",
+			path:        "/found",
+			ifNoneMatch: tag,
+			wantStatus:  http.StatusNotModified,
+			wantETag:    true,
+		},
+		{
+			name:        "weak matching ETag",
+			path:        "/found",
+			ifNoneMatch: `"other", W/` + tag,
+			wantStatus:  http.StatusNotModified,
+			wantETag:    true,
+		},
+		{
+			name:        "ETag of another version",
+			path:        "/found",
+			ifNoneMatch: testETag(t, "0.9.0", "/found"),
+			wantStatus:  http.StatusOK,
+			wantBody:    "found",
+			wantETag:    true,
+		},
+		{
+			name:        "wildcard matches an existing response",
+			path:        "/found",
+			ifNoneMatch: "*",
+			wantStatus:  http.StatusNotModified,
+			wantETag:    true,
+		},
+		{
+			name:        "wildcard doesn't match an error",
+			path:        "/missing",
+			ifNoneMatch: "*",
+			wantStatus:  http.StatusNotFound,
+			wantBody:    `{"message":"Not Found"}` + "\n",
+		},
+		{
+			name:       "errors are not cached",
+			path:       "/missing",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"message":"Not Found"}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set(headerIfNoneMatch, tt.ifNoneMatch)
+			}
+
+			rec := httptest.NewRecorder()
+			newTestEcho(testVersion).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			if rec.Body.String() != tt.wantBody {
+				t.Fatalf("got body %q, want %q", rec.Body.String(), tt.wantBody)
+			}
+
+			if hasETag := rec.Header().Get(headerETag) != ""; hasETag != tt.wantETag {
+				t.Fatalf("got ETag %q, want ETag: %t", rec.Header().Get(headerETag), tt.wantETag)
+			}
+		})
+	}
+}
@@ -0,0 +1,1344 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: legacyapi.proto
+
+package legacyapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NoParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NoParams) Reset() {
+	*x = NoParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoParams) ProtoMessage() {}
+
+func (x *NoParams) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoParams.ProtoReflect.Descriptor instead.
+func (*NoParams) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{0}
+}
+
+type NodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppName                            string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	AppVersion                         string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	LatestMilestone                    string `protobuf:"bytes,3,opt,name=latest_milestone,json=latestMilestone,proto3" json:"latest_milestone,omitempty"`
+	LatestMilestoneIndex               uint32 `protobuf:"varint,4,opt,name=latest_milestone_index,json=latestMilestoneIndex,proto3" json:"latest_milestone_index,omitempty"`
+	LatestSolidSubtangleMilestone      string `protobuf:"bytes,5,opt,name=latest_solid_subtangle_milestone,json=latestSolidSubtangleMilestone,proto3" json:"latest_solid_subtangle_milestone,omitempty"`
+	LatestSolidSubtangleMilestoneIndex uint32 `protobuf:"varint,6,opt,name=latest_solid_subtangle_milestone_index,json=latestSolidSubtangleMilestoneIndex,proto3" json:"latest_solid_subtangle_milestone_index,omitempty"`
+	MilestoneStartIndex                uint32 `protobuf:"varint,7,opt,name=milestone_start_index,json=milestoneStartIndex,proto3" json:"milestone_start_index,omitempty"`
+	LastSnapshottedMilestoneIndex      uint32 `protobuf:"varint,8,opt,name=last_snapshotted_milestone_index,json=lastSnapshottedMilestoneIndex,proto3" json:"last_snapshotted_milestone_index,omitempty"`
+	CoordinatorAddress                 string `protobuf:"bytes,9,opt,name=coordinator_address,json=coordinatorAddress,proto3" json:"coordinator_address,omitempty"`
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NodeInfo) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetLatestMilestone() string {
+	if x != nil {
+		return x.LatestMilestone
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetLatestMilestoneIndex() uint32 {
+	if x != nil {
+		return x.LatestMilestoneIndex
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetLatestSolidSubtangleMilestone() string {
+	if x != nil {
+		return x.LatestSolidSubtangleMilestone
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetLatestSolidSubtangleMilestoneIndex() uint32 {
+	if x != nil {
+		return x.LatestSolidSubtangleMilestoneIndex
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetMilestoneStartIndex() uint32 {
+	if x != nil {
+		return x.MilestoneStartIndex
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetLastSnapshottedMilestoneIndex() uint32 {
+	if x != nil {
+		return x.LastSnapshottedMilestoneIndex
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetCoordinatorAddress() string {
+	if x != nil {
+		return x.CoordinatorAddress
+	}
+	return ""
+}
+
+type GetBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *GetBalancesRequest) Reset() {
+	*x = GetBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesRequest) ProtoMessage() {}
+
+func (x *GetBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBalancesRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type GetBalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balances       []uint64 `protobuf:"varint,1,rep,packed,name=balances,proto3" json:"balances,omitempty"`
+	Reference      string   `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	MilestoneIndex uint32   `protobuf:"varint,3,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
+}
+
+func (x *GetBalancesResponse) Reset() {
+	*x = GetBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesResponse) ProtoMessage() {}
+
+func (x *GetBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBalancesResponse) GetBalances() []uint64 {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GetBalancesResponse) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *GetBalancesResponse) GetMilestoneIndex() uint32 {
+	if x != nil {
+		return x.MilestoneIndex
+	}
+	return 0
+}
+
+type WereAddressesSpentFromRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *WereAddressesSpentFromRequest) Reset() {
+	*x = WereAddressesSpentFromRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WereAddressesSpentFromRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WereAddressesSpentFromRequest) ProtoMessage() {}
+
+func (x *WereAddressesSpentFromRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WereAddressesSpentFromRequest.ProtoReflect.Descriptor instead.
+func (*WereAddressesSpentFromRequest) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WereAddressesSpentFromRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type WereAddressesSpentFromResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []bool `protobuf:"varint,1,rep,packed,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *WereAddressesSpentFromResponse) Reset() {
+	*x = WereAddressesSpentFromResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WereAddressesSpentFromResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WereAddressesSpentFromResponse) ProtoMessage() {}
+
+func (x *WereAddressesSpentFromResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WereAddressesSpentFromResponse.ProtoReflect.Descriptor instead.
+func (*WereAddressesSpentFromResponse) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WereAddressesSpentFromResponse) GetStates() []bool {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type GetInclusionStatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []string `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *GetInclusionStatesRequest) Reset() {
+	*x = GetInclusionStatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInclusionStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInclusionStatesRequest) ProtoMessage() {}
+
+func (x *GetInclusionStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInclusionStatesRequest.ProtoReflect.Descriptor instead.
+func (*GetInclusionStatesRequest) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetInclusionStatesRequest) GetTransactions() []string {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetInclusionStatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []bool `protobuf:"varint,1,rep,packed,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *GetInclusionStatesResponse) Reset() {
+	*x = GetInclusionStatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInclusionStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInclusionStatesResponse) ProtoMessage() {}
+
+func (x *GetInclusionStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInclusionStatesResponse.ProtoReflect.Descriptor instead.
+func (*GetInclusionStatesResponse) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetInclusionStatesResponse) GetStates() []bool {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type GetTrytesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *GetTrytesRequest) Reset() {
+	*x = GetTrytesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrytesRequest) ProtoMessage() {}
+
+func (x *GetTrytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrytesRequest.ProtoReflect.Descriptor instead.
+func (*GetTrytesRequest) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTrytesRequest) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type GetTrytesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trytes []string `protobuf:"bytes,1,rep,name=trytes,proto3" json:"trytes,omitempty"`
+}
+
+func (x *GetTrytesResponse) Reset() {
+	*x = GetTrytesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrytesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrytesResponse) ProtoMessage() {}
+
+func (x *GetTrytesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrytesResponse.ProtoReflect.Descriptor instead.
+func (*GetTrytesResponse) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTrytesResponse) GetTrytes() []string {
+	if x != nil {
+		return x.Trytes
+	}
+	return nil
+}
+
+type FindTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bundles    []string `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	Addresses  []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Tags       []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Approvees  []string `protobuf:"bytes,4,rep,name=approvees,proto3" json:"approvees,omitempty"`
+	MaxResults uint32   `protobuf:"varint,5,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	ValueOnly  bool     `protobuf:"varint,6,opt,name=value_only,json=valueOnly,proto3" json:"value_only,omitempty"`
+}
+
+func (x *FindTransactionsRequest) Reset() {
+	*x = FindTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTransactionsRequest) ProtoMessage() {}
+
+func (x *FindTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*FindTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FindTransactionsRequest) GetBundles() []string {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+func (x *FindTransactionsRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *FindTransactionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FindTransactionsRequest) GetApprovees() []string {
+	if x != nil {
+		return x.Approvees
+	}
+	return nil
+}
+
+func (x *FindTransactionsRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *FindTransactionsRequest) GetValueOnly() bool {
+	if x != nil {
+		return x.ValueOnly
+	}
+	return false
+}
+
+type TransactionHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *TransactionHash) Reset() {
+	*x = TransactionHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionHash) ProtoMessage() {}
+
+func (x *TransactionHash) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionHash.ProtoReflect.Descriptor instead.
+func (*TransactionHash) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransactionHash) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetLedgerStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the index of the milestone, the latest solid milestone is used if zero.
+	TargetIndex uint32 `protobuf:"varint,1,opt,name=target_index,json=targetIndex,proto3" json:"target_index,omitempty"`
+}
+
+func (x *GetLedgerStateRequest) Reset() {
+	*x = GetLedgerStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerStateRequest) ProtoMessage() {}
+
+func (x *GetLedgerStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerStateRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerStateRequest) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLedgerStateRequest) GetTargetIndex() uint32 {
+	if x != nil {
+		return x.TargetIndex
+	}
+	return 0
+}
+
+type AddressBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance     uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	LedgerIndex uint32 `protobuf:"varint,3,opt,name=ledger_index,json=ledgerIndex,proto3" json:"ledger_index,omitempty"`
+}
+
+func (x *AddressBalance) Reset() {
+	*x = AddressBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBalance) ProtoMessage() {}
+
+func (x *AddressBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBalance.ProtoReflect.Descriptor instead.
+func (*AddressBalance) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddressBalance) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressBalance) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AddressBalance) GetLedgerIndex() uint32 {
+	if x != nil {
+		return x.LedgerIndex
+	}
+	return 0
+}
+
+type GetLedgerDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MilestoneIndex uint32 `protobuf:"varint,1,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
+}
+
+func (x *GetLedgerDiffRequest) Reset() {
+	*x = GetLedgerDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerDiffRequest) ProtoMessage() {}
+
+func (x *GetLedgerDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerDiffRequest) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetLedgerDiffRequest) GetMilestoneIndex() uint32 {
+	if x != nil {
+		return x.MilestoneIndex
+	}
+	return 0
+}
+
+type AddressDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address        string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Diff           int64  `protobuf:"varint,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	MilestoneIndex uint32 `protobuf:"varint,3,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
+}
+
+func (x *AddressDiff) Reset() {
+	*x = AddressDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legacyapi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressDiff) ProtoMessage() {}
+
+func (x *AddressDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_legacyapi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressDiff.ProtoReflect.Descriptor instead.
+func (*AddressDiff) Descriptor() ([]byte, []int) {
+	return file_legacyapi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddressDiff) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressDiff) GetDiff() int64 {
+	if x != nil {
+		return x.Diff
+	}
+	return 0
+}
+
+func (x *AddressDiff) GetMilestoneIndex() uint32 {
+	if x != nil {
+		return x.MilestoneIndex
+	}
+	return 0
+}
+
+var File_legacyapi_proto protoreflect.FileDescriptor
+
+var file_legacyapi_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x22, 0x0a, 0x0a, 0x08,
+	0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xf2, 0x03, 0x0a, 0x08, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x6c, 0x65,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x34, 0x0a, 0x16,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x47, 0x0a, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x6f, 0x6c,
+	0x69, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1d, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x6c, 0x69, 0x64, 0x53, 0x75, 0x62, 0x74, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x52, 0x0a, 0x26, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x74,
+	0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x22, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x53, 0x6f, 0x6c, 0x69, 0x64, 0x53, 0x75, 0x62, 0x74, 0x61, 0x6e, 0x67, 0x6c,
+	0x65, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x47, 0x0a, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x74, 0x65, 0x64, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2f, 0x0a, 0x13,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x32, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x22, 0x78, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x1d, 0x57,
+	0x65, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x53, 0x70, 0x65, 0x6e,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x1e, 0x57, 0x65,
+	0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x53, 0x70, 0x65, 0x6e, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x34, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x72, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x25, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x67, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x64, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x44, 0x69, 0x66, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x32, 0xaf, 0x05,
+	0x0a, 0x09, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x41, 0x50, 0x49, 0x12, 0x39, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x13, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x16, 0x57, 0x65, 0x72, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x28, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x72,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x79, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x00, 0x30, 0x01, 0x12, 0x51,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x69,
+	0x66, 0x66, 0x12, 0x1f, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x69, 0x66, 0x66, 0x22, 0x00, 0x30, 0x01, 0x42,
+	0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f,
+	0x74, 0x61, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x78, 0x2d, 0x61, 0x70, 0x69,
+	0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2d, 0x76, 0x30, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_legacyapi_proto_rawDescOnce sync.Once
+	file_legacyapi_proto_rawDescData = file_legacyapi_proto_rawDesc
+)
+
+func file_legacyapi_proto_rawDescGZIP() []byte {
+	file_legacyapi_proto_rawDescOnce.Do(func() {
+		file_legacyapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_legacyapi_proto_rawDescData)
+	})
+	return file_legacyapi_proto_rawDescData
+}
+
+var file_legacyapi_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_legacyapi_proto_goTypes = []interface{}{
+	(*NoParams)(nil),                       // 0: legacyapi.NoParams
+	(*NodeInfo)(nil),                       // 1: legacyapi.NodeInfo
+	(*GetBalancesRequest)(nil),             // 2: legacyapi.GetBalancesRequest
+	(*GetBalancesResponse)(nil),            // 3: legacyapi.GetBalancesResponse
+	(*WereAddressesSpentFromRequest)(nil),  // 4: legacyapi.WereAddressesSpentFromRequest
+	(*WereAddressesSpentFromResponse)(nil), // 5: legacyapi.WereAddressesSpentFromResponse
+	(*GetInclusionStatesRequest)(nil),      // 6: legacyapi.GetInclusionStatesRequest
+	(*GetInclusionStatesResponse)(nil),     // 7: legacyapi.GetInclusionStatesResponse
+	(*GetTrytesRequest)(nil),               // 8: legacyapi.GetTrytesRequest
+	(*GetTrytesResponse)(nil),              // 9: legacyapi.GetTrytesResponse
+	(*FindTransactionsRequest)(nil),        // 10: legacyapi.FindTransactionsRequest
+	(*TransactionHash)(nil),                // 11: legacyapi.TransactionHash
+	(*GetLedgerStateRequest)(nil),          // 12: legacyapi.GetLedgerStateRequest
+	(*AddressBalance)(nil),                 // 13: legacyapi.AddressBalance
+	(*GetLedgerDiffRequest)(nil),           // 14: legacyapi.GetLedgerDiffRequest
+	(*AddressDiff)(nil),                    // 15: legacyapi.AddressDiff
+}
+var file_legacyapi_proto_depIdxs = []int32{
+	0,  // 0: legacyapi.LegacyAPI.GetNodeInfo:input_type -> legacyapi.NoParams
+	2,  // 1: legacyapi.LegacyAPI.GetBalances:input_type -> legacyapi.GetBalancesRequest
+	4,  // 2: legacyapi.LegacyAPI.WereAddressesSpentFrom:input_type -> legacyapi.WereAddressesSpentFromRequest
+	6,  // 3: legacyapi.LegacyAPI.GetInclusionStates:input_type -> legacyapi.GetInclusionStatesRequest
+	8,  // 4: legacyapi.LegacyAPI.GetTrytes:input_type -> legacyapi.GetTrytesRequest
+	10, // 5: legacyapi.LegacyAPI.FindTransactions:input_type -> legacyapi.FindTransactionsRequest
+	12, // 6: legacyapi.LegacyAPI.GetLedgerState:input_type -> legacyapi.GetLedgerStateRequest
+	14, // 7: legacyapi.LegacyAPI.GetLedgerDiff:input_type -> legacyapi.GetLedgerDiffRequest
+	1,  // 8: legacyapi.LegacyAPI.GetNodeInfo:output_type -> legacyapi.NodeInfo
+	3,  // 9: legacyapi.LegacyAPI.GetBalances:output_type -> legacyapi.GetBalancesResponse
+	5,  // 10: legacyapi.LegacyAPI.WereAddressesSpentFrom:output_type -> legacyapi.WereAddressesSpentFromResponse
+	7,  // 11: legacyapi.LegacyAPI.GetInclusionStates:output_type -> legacyapi.GetInclusionStatesResponse
+	9,  // 12: legacyapi.LegacyAPI.GetTrytes:output_type -> legacyapi.GetTrytesResponse
+	11, // 13: legacyapi.LegacyAPI.FindTransactions:output_type -> legacyapi.TransactionHash
+	13, // 14: legacyapi.LegacyAPI.GetLedgerState:output_type -> legacyapi.AddressBalance
+	15, // 15: legacyapi.LegacyAPI.GetLedgerDiff:output_type -> legacyapi.AddressDiff
+	8,  // [8:16] is the sub-list for method output_type
+	0,  // [0:8] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_legacyapi_proto_init() }
+func file_legacyapi_proto_init() {
+	if File_legacyapi_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_legacyapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WereAddressesSpentFromRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WereAddressesSpentFromResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInclusionStatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInclusionStatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrytesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrytesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legacyapi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_legacyapi_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_legacyapi_proto_goTypes,
+		DependencyIndexes: file_legacyapi_proto_depIdxs,
+		MessageInfos:      file_legacyapi_proto_msgTypes,
+	}.Build()
+	File_legacyapi_proto = out.File
+	file_legacyapi_proto_rawDesc = nil
+	file_legacyapi_proto_goTypes = nil
+	file_legacyapi_proto_depIdxs = nil
+}
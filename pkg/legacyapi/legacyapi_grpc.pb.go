@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: legacyapi.proto
+
+package legacyapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LegacyAPIClient is the client API for LegacyAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LegacyAPIClient interface {
+	GetNodeInfo(ctx context.Context, in *NoParams, opts ...grpc.CallOption) (*NodeInfo, error)
+	GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error)
+	WereAddressesSpentFrom(ctx context.Context, in *WereAddressesSpentFromRequest, opts ...grpc.CallOption) (*WereAddressesSpentFromResponse, error)
+	GetInclusionStates(ctx context.Context, in *GetInclusionStatesRequest, opts ...grpc.CallOption) (*GetInclusionStatesResponse, error)
+	GetTrytes(ctx context.Context, in *GetTrytesRequest, opts ...grpc.CallOption) (*GetTrytesResponse, error)
+	FindTransactions(ctx context.Context, in *FindTransactionsRequest, opts ...grpc.CallOption) (LegacyAPI_FindTransactionsClient, error)
+	GetLedgerState(ctx context.Context, in *GetLedgerStateRequest, opts ...grpc.CallOption) (LegacyAPI_GetLedgerStateClient, error)
+	GetLedgerDiff(ctx context.Context, in *GetLedgerDiffRequest, opts ...grpc.CallOption) (LegacyAPI_GetLedgerDiffClient, error)
+}
+
+type legacyAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLegacyAPIClient(cc grpc.ClientConnInterface) LegacyAPIClient {
+	return &legacyAPIClient{cc}
+}
+
+func (c *legacyAPIClient) GetNodeInfo(ctx context.Context, in *NoParams, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, "/legacyapi.LegacyAPI/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legacyAPIClient) GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error) {
+	out := new(GetBalancesResponse)
+	err := c.cc.Invoke(ctx, "/legacyapi.LegacyAPI/GetBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legacyAPIClient) WereAddressesSpentFrom(ctx context.Context, in *WereAddressesSpentFromRequest, opts ...grpc.CallOption) (*WereAddressesSpentFromResponse, error) {
+	out := new(WereAddressesSpentFromResponse)
+	err := c.cc.Invoke(ctx, "/legacyapi.LegacyAPI/WereAddressesSpentFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legacyAPIClient) GetInclusionStates(ctx context.Context, in *GetInclusionStatesRequest, opts ...grpc.CallOption) (*GetInclusionStatesResponse, error) {
+	out := new(GetInclusionStatesResponse)
+	err := c.cc.Invoke(ctx, "/legacyapi.LegacyAPI/GetInclusionStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legacyAPIClient) GetTrytes(ctx context.Context, in *GetTrytesRequest, opts ...grpc.CallOption) (*GetTrytesResponse, error) {
+	out := new(GetTrytesResponse)
+	err := c.cc.Invoke(ctx, "/legacyapi.LegacyAPI/GetTrytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legacyAPIClient) FindTransactions(ctx context.Context, in *FindTransactionsRequest, opts ...grpc.CallOption) (LegacyAPI_FindTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LegacyAPI_ServiceDesc.Streams[0], "/legacyapi.LegacyAPI/FindTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &legacyAPIFindTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LegacyAPI_FindTransactionsClient interface {
+	Recv() (*TransactionHash, error)
+	grpc.ClientStream
+}
+
+type legacyAPIFindTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *legacyAPIFindTransactionsClient) Recv() (*TransactionHash, error) {
+	m := new(TransactionHash)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *legacyAPIClient) GetLedgerState(ctx context.Context, in *GetLedgerStateRequest, opts ...grpc.CallOption) (LegacyAPI_GetLedgerStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LegacyAPI_ServiceDesc.Streams[1], "/legacyapi.LegacyAPI/GetLedgerState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &legacyAPIGetLedgerStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LegacyAPI_GetLedgerStateClient interface {
+	Recv() (*AddressBalance, error)
+	grpc.ClientStream
+}
+
+type legacyAPIGetLedgerStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *legacyAPIGetLedgerStateClient) Recv() (*AddressBalance, error) {
+	m := new(AddressBalance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *legacyAPIClient) GetLedgerDiff(ctx context.Context, in *GetLedgerDiffRequest, opts ...grpc.CallOption) (LegacyAPI_GetLedgerDiffClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LegacyAPI_ServiceDesc.Streams[2], "/legacyapi.LegacyAPI/GetLedgerDiff", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &legacyAPIGetLedgerDiffClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LegacyAPI_GetLedgerDiffClient interface {
+	Recv() (*AddressDiff, error)
+	grpc.ClientStream
+}
+
+type legacyAPIGetLedgerDiffClient struct {
+	grpc.ClientStream
+}
+
+func (x *legacyAPIGetLedgerDiffClient) Recv() (*AddressDiff, error) {
+	m := new(AddressDiff)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LegacyAPIServer is the server API for LegacyAPI service.
+// All implementations must embed UnimplementedLegacyAPIServer
+// for forward compatibility
+type LegacyAPIServer interface {
+	GetNodeInfo(context.Context, *NoParams) (*NodeInfo, error)
+	GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error)
+	WereAddressesSpentFrom(context.Context, *WereAddressesSpentFromRequest) (*WereAddressesSpentFromResponse, error)
+	GetInclusionStates(context.Context, *GetInclusionStatesRequest) (*GetInclusionStatesResponse, error)
+	GetTrytes(context.Context, *GetTrytesRequest) (*GetTrytesResponse, error)
+	FindTransactions(*FindTransactionsRequest, LegacyAPI_FindTransactionsServer) error
+	GetLedgerState(*GetLedgerStateRequest, LegacyAPI_GetLedgerStateServer) error
+	GetLedgerDiff(*GetLedgerDiffRequest, LegacyAPI_GetLedgerDiffServer) error
+	mustEmbedUnimplementedLegacyAPIServer()
+}
+
+// UnimplementedLegacyAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedLegacyAPIServer struct {
+}
+
+func (UnimplementedLegacyAPIServer) GetNodeInfo(context.Context, *NoParams) (*NodeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+}
+func (UnimplementedLegacyAPIServer) GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalances not implemented")
+}
+func (UnimplementedLegacyAPIServer) WereAddressesSpentFrom(context.Context, *WereAddressesSpentFromRequest) (*WereAddressesSpentFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WereAddressesSpentFrom not implemented")
+}
+func (UnimplementedLegacyAPIServer) GetInclusionStates(context.Context, *GetInclusionStatesRequest) (*GetInclusionStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionStates not implemented")
+}
+func (UnimplementedLegacyAPIServer) GetTrytes(context.Context, *GetTrytesRequest) (*GetTrytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrytes not implemented")
+}
+func (UnimplementedLegacyAPIServer) FindTransactions(*FindTransactionsRequest, LegacyAPI_FindTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindTransactions not implemented")
+}
+func (UnimplementedLegacyAPIServer) GetLedgerState(*GetLedgerStateRequest, LegacyAPI_GetLedgerStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLedgerState not implemented")
+}
+func (UnimplementedLegacyAPIServer) GetLedgerDiff(*GetLedgerDiffRequest, LegacyAPI_GetLedgerDiffServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLedgerDiff not implemented")
+}
+func (UnimplementedLegacyAPIServer) mustEmbedUnimplementedLegacyAPIServer() {}
+
+// UnsafeLegacyAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LegacyAPIServer will
+// result in compilation errors.
+type UnsafeLegacyAPIServer interface {
+	mustEmbedUnimplementedLegacyAPIServer()
+}
+
+func RegisterLegacyAPIServer(s grpc.ServiceRegistrar, srv LegacyAPIServer) {
+	s.RegisterService(&LegacyAPI_ServiceDesc, srv)
+}
+
+func _LegacyAPI_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegacyAPIServer).GetNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/legacyapi.LegacyAPI/GetNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegacyAPIServer).GetNodeInfo(ctx, req.(*NoParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegacyAPI_GetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegacyAPIServer).GetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/legacyapi.LegacyAPI/GetBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegacyAPIServer).GetBalances(ctx, req.(*GetBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegacyAPI_WereAddressesSpentFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WereAddressesSpentFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegacyAPIServer).WereAddressesSpentFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/legacyapi.LegacyAPI/WereAddressesSpentFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegacyAPIServer).WereAddressesSpentFrom(ctx, req.(*WereAddressesSpentFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegacyAPI_GetInclusionStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInclusionStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegacyAPIServer).GetInclusionStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/legacyapi.LegacyAPI/GetInclusionStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegacyAPIServer).GetInclusionStates(ctx, req.(*GetInclusionStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegacyAPI_GetTrytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegacyAPIServer).GetTrytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/legacyapi.LegacyAPI/GetTrytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegacyAPIServer).GetTrytes(ctx, req.(*GetTrytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegacyAPI_FindTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LegacyAPIServer).FindTransactions(m, &legacyAPIFindTransactionsServer{stream})
+}
+
+type LegacyAPI_FindTransactionsServer interface {
+	Send(*TransactionHash) error
+	grpc.ServerStream
+}
+
+type legacyAPIFindTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *legacyAPIFindTransactionsServer) Send(m *TransactionHash) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LegacyAPI_GetLedgerState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLedgerStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LegacyAPIServer).GetLedgerState(m, &legacyAPIGetLedgerStateServer{stream})
+}
+
+type LegacyAPI_GetLedgerStateServer interface {
+	Send(*AddressBalance) error
+	grpc.ServerStream
+}
+
+type legacyAPIGetLedgerStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *legacyAPIGetLedgerStateServer) Send(m *AddressBalance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LegacyAPI_GetLedgerDiff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLedgerDiffRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LegacyAPIServer).GetLedgerDiff(m, &legacyAPIGetLedgerDiffServer{stream})
+}
+
+type LegacyAPI_GetLedgerDiffServer interface {
+	Send(*AddressDiff) error
+	grpc.ServerStream
+}
+
+type legacyAPIGetLedgerDiffServer struct {
+	grpc.ServerStream
+}
+
+func (x *legacyAPIGetLedgerDiffServer) Send(m *AddressDiff) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LegacyAPI_ServiceDesc is the grpc.ServiceDesc for LegacyAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LegacyAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "legacyapi.LegacyAPI",
+	HandlerType: (*LegacyAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _LegacyAPI_GetNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetBalances",
+			Handler:    _LegacyAPI_GetBalances_Handler,
+		},
+		{
+			MethodName: "WereAddressesSpentFrom",
+			Handler:    _LegacyAPI_WereAddressesSpentFrom_Handler,
+		},
+		{
+			MethodName: "GetInclusionStates",
+			Handler:    _LegacyAPI_GetInclusionStates_Handler,
+		},
+		{
+			MethodName: "GetTrytes",
+			Handler:    _LegacyAPI_GetTrytes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FindTransactions",
+			Handler:       _LegacyAPI_FindTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLedgerState",
+			Handler:       _LegacyAPI_GetLedgerState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLedgerDiff",
+			Handler:       _LegacyAPI_GetLedgerDiff_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "legacyapi.proto",
+}
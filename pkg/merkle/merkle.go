@@ -0,0 +1,126 @@
+// Package merkle implements binary Merkle trees with domain separated leaf and node hashes.
+// The root is equal to the Merkle Tree Hash of RFC 6962 using BLAKE2b-256 as hash function.
+package merkle
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	leafHashPrefix = 0x00
+	nodeHashPrefix = 0x01
+)
+
+// Hash is the BLAKE2b-256 hash of a leaf or a node of the tree.
+type Hash [blake2b.Size256]byte
+
+// String returns the hex encoding of the hash.
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// LeafHash returns the hash of a leaf with the given data.
+func LeafHash(data []byte) Hash {
+	return blake2b.Sum256(append([]byte{leafHashPrefix}, data...))
+}
+
+// NodeHash returns the hash of an inner node with the given children.
+func NodeHash(left Hash, right Hash) Hash {
+	data := make([]byte, 0, 1+2*blake2b.Size256)
+	data = append(data, nodeHashPrefix)
+	data = append(data, left[:]...)
+	data = append(data, right[:]...)
+
+	return blake2b.Sum256(data)
+}
+
+// ProofStep is a sibling on the path from a leaf to the root.
+type ProofStep struct {
+	// Hash is the hash of the sibling.
+	Hash Hash
+	// IsLeft tells whether the sibling is the left child of the parent.
+	IsLeft bool
+}
+
+// Tree is a Merkle tree that keeps all levels in memory to create inclusion proofs.
+type Tree struct {
+	// levels[0] are the leaves, the last level is the root.
+	levels [][]Hash
+}
+
+// New creates a Merkle tree over the given leaf hashes.
+// A node without a sibling is promoted to the next level unchanged,
+// which results in the same root as the recursive definition of RFC 6962.
+func New(leaves []Hash) *Tree {
+	levels := [][]Hash{leaves}
+
+	for level := leaves; len(level) > 1; {
+		next := make([]Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+
+				continue
+			}
+			next = append(next, NodeHash(level[i], level[i+1]))
+		}
+
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &Tree{levels: levels}
+}
+
+// LeafCount returns the amount of leaves of the tree.
+func (t *Tree) LeafCount() int {
+	return len(t.levels[0])
+}
+
+// Root returns the root of the tree. The root of an empty tree is the hash of empty data.
+func (t *Tree) Root() Hash {
+	if t.LeafCount() == 0 {
+		return blake2b.Sum256(nil)
+	}
+
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Proof returns the siblings on the path from the leaf with the given index to the root.
+func (t *Tree) Proof(index int) ([]*ProofStep, error) {
+	if index < 0 || index >= t.LeafCount() {
+		return nil, fmt.Errorf("leaf index out of range: %d, leaf count: %d", index, t.LeafCount())
+	}
+
+	proof := make([]*ProofStep, 0, len(t.levels)-1)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, &ProofStep{
+				Hash:   level[sibling],
+				IsLeft: sibling < index,
+			})
+		}
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks whether the leaf is part of the tree with the given root.
+func VerifyProof(leaf Hash, proof []*ProofStep, root Hash) bool {
+	hash := leaf
+	for _, step := range proof {
+		if step.IsLeft {
+			hash = NodeHash(step.Hash, hash)
+
+			continue
+		}
+		hash = NodeHash(hash, step.Hash)
+	}
+
+	return hash == root
+}
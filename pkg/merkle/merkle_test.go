@@ -0,0 +1,153 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// testLeaves returns the hashes of the given amount of distinct leaves.
+func testLeaves(count int) []Hash {
+	leaves := make([]Hash, count)
+	for i := range leaves {
+		leaves[i] = LeafHash([]byte(fmt.Sprintf("leaf %d", i)))
+	}
+
+	return leaves
+}
+
+// rfc6962Root computes the Merkle Tree Hash of RFC 6962, section 2.1, recursively:
+// the leaves are split at the largest power of two that is smaller than the amount of leaves.
+func rfc6962Root(leaves []Hash) Hash {
+	switch len(leaves) {
+	case 0:
+		return blake2b.Sum256(nil)
+	case 1:
+		return leaves[0]
+	}
+
+	split := 1
+	for split*2 < len(leaves) {
+		split *= 2
+	}
+
+	return NodeHash(rfc6962Root(leaves[:split]), rfc6962Root(leaves[split:]))
+}
+
+func TestLeafAndNodeHashesAreDomainSeparated(t *testing.T) {
+	left := LeafHash([]byte("left"))
+	right := LeafHash([]byte("right"))
+
+	// a leaf whose data is the concatenation of two hashes must not collide with their parent node
+	data := append(append([]byte{}, left[:]...), right[:]...)
+	if LeafHash(data) == NodeHash(left, right) {
+		t.Fatal("leaf hash equals node hash")
+	}
+}
+
+func TestRootMatchesRFC6962(t *testing.T) {
+	for count := 0; count <= 33; count++ {
+		t.Run(fmt.Sprintf("%d leaves", count), func(t *testing.T) {
+			leaves := testLeaves(count)
+
+			if root, want := New(leaves).Root(), rfc6962Root(leaves); root != want {
+				t.Fatalf("got root %s, want %s", root, want)
+			}
+		})
+	}
+}
+
+func TestProofRoundTrip(t *testing.T) {
+	for _, count := range []int{1, 2, 3, 5, 8, 13, 16, 17} {
+		t.Run(fmt.Sprintf("%d leaves", count), func(t *testing.T) {
+			leaves := testLeaves(count)
+			tree := New(leaves)
+
+			for index, leaf := range leaves {
+				proof, err := tree.Proof(index)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !VerifyProof(leaf, proof, tree.Root()) {
+					t.Fatalf("proof of leaf %d is invalid", index)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyProofRejectsTampering(t *testing.T) {
+	leaves := testLeaves(11)
+	tree := New(leaves)
+
+	const index = 6
+	proof, err := tree.Proof(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		leaf   Hash
+		proof  func() []*ProofStep
+		root   Hash
+		wantOK bool
+	}{
+		{
+			name:   "valid proof",
+			leaf:   leaves[index],
+			proof:  func() []*ProofStep { return proof },
+			root:   tree.Root(),
+			wantOK: true,
+		},
+		{
+			name:  "other leaf",
+			leaf:  leaves[index+1],
+			proof: func() []*ProofStep { return proof },
+			root:  tree.Root(),
+		},
+		{
+			name:  "other root",
+			leaf:  leaves[index],
+			proof: func() []*ProofStep { return proof },
+			root:  New(leaves[:10]).Root(),
+		},
+		{
+			name: "swapped side",
+			leaf: leaves[index],
+			proof: func() []*ProofStep {
+				swapped := append([]*ProofStep{}, proof...)
+				swapped[0] = &ProofStep{Hash: proof[0].Hash, IsLeft: !proof[0].IsLeft}
+
+				return swapped
+			},
+			root: tree.Root(),
+		},
+		{
+			name:  "missing step",
+			leaf:  leaves[index],
+			proof: func() []*ProofStep { return proof[:len(proof)-1] },
+			root:  tree.Root(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := VerifyProof(tt.leaf, tt.proof(), tt.root); ok != tt.wantOK {
+				t.Fatalf("got valid %t, want %t", ok, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestProofIndexOutOfRange(t *testing.T) {
+	tree := New(testLeaves(4))
+
+	for _, index := range []int{-1, 4} {
+		if _, err := tree.Proof(index); err == nil {
+			t.Fatalf("got no error for leaf index %d", index)
+		}
+	}
+}
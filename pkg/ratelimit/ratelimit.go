@@ -0,0 +1,199 @@
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/auth"
+)
+
+const (
+	clientTypeIP     = "ip"
+	clientTypeAPIKey = "apiKey"
+)
+
+var (
+	// ErrLimitExceeded is returned if the bucket of the client doesn't contain enough tokens.
+	ErrLimitExceeded = errors.New("rate limit exceeded")
+	// ErrCostExceedsBurst is returned if a request consumes more tokens than the bucket of a client can hold.
+	ErrCostExceedsBurst = errors.New("request cost exceeds burst")
+)
+
+// CostFunc returns the amount of tokens a request consumes.
+type CostFunc func(c echo.Context) int
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter holds a token bucket for every client.
+type Limiter struct {
+	limit rate.Limit
+	burst int
+
+	bucketsLock sync.Mutex
+	buckets     map[string]*bucket
+}
+
+// NewLimiter creates a new Limiter that refills the given amount of tokens per second
+// up to the given burst size.
+func NewLimiter(tokensPerSecond int, burst int) *Limiter {
+	return &Limiter{
+		limit:   rate.Limit(tokensPerSecond),
+		burst:   burst,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Burst returns the maximum amount of tokens in the bucket of a client.
+func (l *Limiter) Burst() int {
+	return l.burst
+}
+
+// Take consumes the given amount of tokens from the bucket of the client.
+// It returns ErrLimitExceeded and the time until enough tokens are available if the bucket doesn't contain enough tokens.
+// It returns ErrCostExceedsBurst if the bucket can never contain enough tokens.
+func (l *Limiter) Take(client string, tokens int) (time.Duration, error) {
+	if tokens > l.burst {
+		return 0, ErrCostExceedsBurst
+	}
+
+	l.bucketsLock.Lock()
+	defer l.bucketsLock.Unlock()
+
+	now := time.Now()
+
+	b, exists := l.buckets[client]
+	if !exists {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[client] = b
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, tokens)
+	if !reservation.OK() {
+		return 0, ErrCostExceedsBurst
+	}
+
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		// give the tokens back, the request will not be processed
+		reservation.CancelAt(now)
+
+		return delay, ErrLimitExceeded
+	}
+
+	return 0, nil
+}
+
+// Cleanup removes the buckets of all clients that were not seen for the given duration.
+func (l *Limiter) Cleanup(maxIdle time.Duration) {
+	l.bucketsLock.Lock()
+	defer l.bucketsLock.Unlock()
+
+	for client, b := range l.buckets {
+		if time.Since(b.lastSeen) > maxIdle {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// RateLimiter limits the requests of every client by IP or, if a known API key is given, by API key.
+type RateLimiter struct {
+	ipLimiter     *Limiter
+	apiKeyLimiter *Limiter
+	apiKeys       map[string]struct{}
+	costFunc      CostFunc
+
+	rejectedRequests *prometheus.CounterVec
+}
+
+// New creates a new RateLimiter.
+func New(ipLimiter *Limiter, apiKeyLimiter *Limiter, apiKeys []string, costFunc CostFunc) *RateLimiter {
+	knownAPIKeys := make(map[string]struct{}, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		knownAPIKeys[apiKey] = struct{}{}
+	}
+
+	return &RateLimiter{
+		ipLimiter:     ipLimiter,
+		apiKeyLimiter: apiKeyLimiter,
+		apiKeys:       knownAPIKeys,
+		costFunc:      costFunc,
+		rejectedRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "iota",
+				Subsystem: "restapi",
+				Name:      "ratelimit_rejected_requests_total",
+				Help:      "The number of requests that were rejected by the rate limiter.",
+			},
+			[]string{"route", "client_type"},
+		),
+	}
+}
+
+// Collectors returns the prometheus collectors of the RateLimiter.
+func (r *RateLimiter) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{r.rejectedRequests}
+}
+
+// Cleanup removes the buckets of all clients that were not seen for the given duration.
+func (r *RateLimiter) Cleanup(maxIdle time.Duration) {
+	r.ipLimiter.Cleanup(maxIdle)
+	r.apiKeyLimiter.Cleanup(maxIdle)
+}
+
+// Allow consumes the given amount of tokens from the bucket of the client with the given IP,
+// or of the given API key if it is known. Rejected requests are counted for the given route.
+// It returns the errors of Limiter.Take.
+func (r *RateLimiter) Allow(route string, ip string, apiKey string, tokens int) (time.Duration, error) {
+	limiter := r.ipLimiter
+	client := ip
+	clientType := clientTypeIP
+
+	if apiKey != "" {
+		// unknown API keys are limited by IP, otherwise every random key would get a new bucket
+		if _, known := r.apiKeys[apiKey]; known {
+			limiter = r.apiKeyLimiter
+			client = apiKey
+			clientType = clientTypeAPIKey
+		}
+	}
+
+	retryAfter, err := limiter.Take(client, tokens)
+	if err != nil {
+		r.rejectedRequests.WithLabelValues(route, clientType).Inc()
+	}
+
+	return retryAfter, err
+}
+
+// Middleware returns an echo middleware that rejects requests of clients that exceeded their limit.
+func (r *RateLimiter) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// the client IP is only taken from the headers of trusted proxies, see the IPExtractor of echo
+			retryAfter, err := r.Allow(c.Path(), c.RealIP(), c.Request().Header.Get(auth.HeaderAPIKey), r.costFunc(c))
+			if err != nil {
+				if errors.Is(err, ErrCostExceedsBurst) {
+					// retrying the request will never succeed
+					return echo.NewHTTPError(http.StatusRequestEntityTooLarge, err.Error())
+				}
+
+				c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+
+				return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+			}
+
+			return next(c)
+		}
+	}
+}
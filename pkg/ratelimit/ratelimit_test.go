@@ -0,0 +1,153 @@
+package ratelimit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/auth"
+)
+
+func TestLimiterTake(t *testing.T) {
+	tests := []struct {
+		name    string
+		burst   int
+		takes   []int
+		wantErr []error
+	}{
+		{
+			name:    "within burst",
+			burst:   10,
+			takes:   []int{4, 6},
+			wantErr: []error{nil, nil},
+		},
+		{
+			name:    "bucket exhausted",
+			burst:   10,
+			takes:   []int{8, 3, 2},
+			wantErr: []error{nil, ErrLimitExceeded, nil},
+		},
+		{
+			name:    "cost exceeds burst",
+			burst:   10,
+			takes:   []int{11, 10},
+			wantErr: []error{ErrCostExceedsBurst, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the bucket is not refilled noticeably during the test
+			l := NewLimiter(1, tt.burst)
+
+			for i, tokens := range tt.takes {
+				retryAfter, err := l.Take("client", tokens)
+				if !errors.Is(err, tt.wantErr[i]) {
+					t.Fatalf("take %d: got error %v, want %v", i, err, tt.wantErr[i])
+				}
+
+				if errors.Is(err, ErrLimitExceeded) && retryAfter <= 0 {
+					t.Fatalf("take %d: got retry after %s, want a positive duration", i, retryAfter)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterClientsAreIndependent(t *testing.T) {
+	l := NewLimiter(1, 5)
+
+	if _, err := l.Take("a", 5); err != nil {
+		t.Fatalf("client a: %v", err)
+	}
+
+	if _, err := l.Take("b", 5); err != nil {
+		t.Fatalf("client b: %v", err)
+	}
+
+	if _, err := l.Take("a", 1); !errors.Is(err, ErrLimitExceeded) {
+		t.Fatalf("client a: got error %v, want %v", err, ErrLimitExceeded)
+	}
+}
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	const knownAPIKey = "known"
+
+	tests := []struct {
+		name          string
+		cost          int
+		apiKey        string
+		xForwardedFor string
+		wantStatus    []int
+	}{
+		{
+			name:       "limited by ip",
+			cost:       3,
+			wantStatus: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:       "limited by known api key",
+			cost:       3,
+			apiKey:     knownAPIKey,
+			wantStatus: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:       "unknown api key is limited by ip",
+			cost:       3,
+			apiKey:     "unknown",
+			wantStatus: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:          "forwarded header of untrusted client is ignored",
+			cost:          3,
+			xForwardedFor: "1.2.3.4",
+			wantStatus:    []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:       "cost exceeds burst",
+			cost:       10,
+			wantStatus: []int{http.StatusRequestEntityTooLarge, http.StatusRequestEntityTooLarge},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(NewLimiter(1, 5), NewLimiter(1, 5), []string{knownAPIKey}, func(c echo.Context) int { return tt.cost })
+
+			e := echo.New()
+			e.IPExtractor = echo.ExtractIPDirect()
+			e.Use(r.Middleware())
+			e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+			for i, wantStatus := range tt.wantStatus {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				if tt.apiKey != "" {
+					req.Header.Set(auth.HeaderAPIKey, tt.apiKey)
+				}
+				if tt.xForwardedFor != "" {
+					// every request claims to come from a different client
+					req.Header.Set(echo.HeaderXForwardedFor, tt.xForwardedFor+string(rune('0'+i)))
+				}
+
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+
+				if rec.Code != wantStatus {
+					t.Fatalf("request %d: got status %d, want %d", i, rec.Code, wantStatus)
+				}
+
+				retryAfter := rec.Header().Get(echo.HeaderRetryAfter)
+				if wantStatus == http.StatusTooManyRequests && (retryAfter == "" || retryAfter == "0") {
+					t.Fatalf("request %d: got retry after %q, want a positive value", i, retryAfter)
+				}
+
+				if wantStatus == http.StatusRequestEntityTooLarge && retryAfter != "" {
+					t.Fatalf("request %d: got retry after %q, want none", i, retryAfter)
+				}
+			}
+		})
+	}
+}
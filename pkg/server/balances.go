@@ -7,7 +7,6 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/inx-app/pkg/httpserver"
-	"github.com/iotaledger/iota.go/address"
 
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
@@ -22,18 +21,21 @@ func (s *DatabaseServer) rpcGetBalances(c echo.Context) (interface{}, error) {
 		return nil, errors.WithMessage(httpserver.ErrInvalidParameter, "invalid request, error: no addresses provided")
 	}
 
+	addresses := make(hornet.Hashes, 0, len(request.Addresses))
 	for _, addr := range request.Addresses {
 		// Check if address is valid
-		if err := address.ValidAddress(addr); err != nil {
-			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address hash provided: %s", addr)
+		addrHash, err := parseAddress(addr)
+		if err != nil {
+			return nil, err
 		}
+		addresses = append(addresses, addrHash)
 	}
 
 	result := &GetBalancesResponse{}
 
-	for _, addr := range request.Addresses {
+	for _, addr := range addresses {
 
-		balance, _, err := s.Database.GetBalanceForAddress(hornet.HashFromAddressTrytes(addr))
+		balance, _, err := s.Database.GetBalanceForAddressContext(c.Request().Context(), addr)
 		if err != nil {
 			return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
 		}
@@ -57,13 +59,18 @@ func (s *DatabaseServer) addressBalance(c echo.Context) (interface{}, error) {
 		return nil, err
 	}
 
-	balance, _, err := s.Database.GetBalanceForAddress(addr)
+	checksum, err := parseBoolQueryParam(c, QueryParameterChecksum)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, _, err := s.Database.GetBalanceForAddressContext(c.Request().Context(), addr)
 	if err != nil {
 		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
 	}
 
 	return &balanceResponse{
-		Address:     addr.Trytes(),
+		Address:     addressWithChecksum(addr.Trytes(), checksum),
 		Balance:     strconv.FormatUint(balance, 10),
 		LedgerIndex: s.Database.GetLedgerIndex(),
 	}, nil
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/iota.go/trinary"
+)
+
+func (s *DatabaseServer) bundleReattachments(c echo.Context) (interface{}, error) {
+	bundleHash, err := parseBundleHashParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := parseMaxResultsQueryParam(c, s.RestAPILimitsMaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &bundleReattachmentsResponse{
+		Bundle:      bundleHash.Trytes(),
+		Attachments: []*bundleAttachment{},
+		LedgerIndex: s.Database.GetLedgerIndex(),
+	}
+
+	for _, tailTxHash := range s.Database.GetBundleTailTransactionHashesContext(c.Request().Context(), bundleHash, maxResults) {
+		txMeta := s.Database.GetTxMetadataOrNilContext(c.Request().Context(), tailTxHash)
+		if txMeta == nil {
+			// tail transaction was pruned
+			continue
+		}
+
+		confirmed, confirmationIndex := txMeta.GetConfirmed()
+
+		attachment := &bundleAttachment{
+			TailTxHash:  tailTxHash.Trytes(),
+			Confirmed:   confirmed,
+			Conflicting: txMeta.IsConflicting(),
+		}
+
+		if confirmed {
+			attachment.ConfirmationIndex = confirmationIndex
+		}
+
+		// the bundle is only stored if all transactions of the attachment are known
+		if bndl := s.Database.GetBundleOrNilContext(c.Request().Context(), tailTxHash); bndl != nil {
+			attachment.Complete = true
+			attachment.Valid = bndl.IsValid()
+		}
+
+		result.Attachments = append(result.Attachments, attachment)
+
+		if !attachment.Confirmed || attachment.Conflicting {
+			continue
+		}
+
+		// zero value bundles can be confirmed several times, the first confirmation wins
+		if result.ConfirmedTailTxHash == "" || attachment.ConfirmationIndex < result.ConfirmationIndex {
+			result.ConfirmedTailTxHash = attachment.TailTxHash
+			result.ConfirmationIndex = attachment.ConfirmationIndex
+		}
+	}
+
+	return result, nil
+}
+
+func (s *DatabaseServer) transactionBundleValidation(c echo.Context) (interface{}, error) {
+	txHash, err := parseTransactionHashParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	validation := s.Database.ValidateBundleOrNil(c.Request().Context(), txHash)
+	if validation == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "transaction not found: %s", txHash.Trytes())
+	}
+
+	result := &bundleValidationResponse{
+		TailTxHash:         validation.TailTxHash.Trytes(),
+		Bundle:             validation.BundleHash,
+		ComputedBundleHash: validation.ComputedBundleHash,
+		TxHashes:           make([]trinary.Hash, 0, len(validation.Transactions)),
+		Valid:              validation.Valid(),
+		Failures:           make([]*bundleValidationFailure, 0, len(validation.Failures)),
+		Stored:             validation.Stored,
+		StoredValid:        validation.StoredValid,
+		LedgerIndex:        s.Database.GetLedgerIndex(),
+	}
+
+	for _, tx := range validation.Transactions {
+		result.TxHashes = append(result.TxHashes, tx.Tx.Hash)
+	}
+
+	for _, failure := range validation.Failures {
+		result.Failures = append(result.Failures, &bundleValidationFailure{
+			Check: failure.Check,
+			Error: failure.Error,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/databasetest"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+func TestBundleReattachments(t *testing.T) {
+	tangle := databasetest.New(t)
+
+	var bundleHash trinary.Hash
+	// every attachment of the bundle approves other transactions, so the tails have different hashes
+	addAttachment := func(confirmationIndex milestone.Index) trinary.Hash {
+		approvee := tangle.AddTransaction(tangle.NewTransaction(databasetest.NullHash, databasetest.NullHash), 0)
+
+		tx := tangle.NewTransaction(approvee, approvee)
+		if bundleHash == "" {
+			bundleHash = tx.Bundle
+		}
+		tx.Bundle = bundleHash
+
+		tailTxHash := tangle.AddTransaction(tx, confirmationIndex)
+		tangle.AddBundle([]*transaction.Transaction{tx}, true, nil)
+
+		return tailTxHash
+	}
+
+	unconfirmed := addAttachment(0)
+	confirmedLate := addAttachment(5)
+	confirmedFirst := addAttachment(3)
+	conflicting := addAttachment(2)
+	tangle.MarkConflicting(conflicting)
+
+	s := &DatabaseServer{
+		Database:                tangle.Database(),
+		RestAPILimitsMaxResults: 100,
+	}
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	c.SetPath(RouteBundleReattachments)
+	c.SetParamNames(ParameterBundleHash)
+	c.SetParamValues(bundleHash)
+
+	resp, err := s.bundleReattachments(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := resp.(*bundleReattachmentsResponse)
+
+	if len(result.Attachments) != 4 {
+		t.Fatalf("got %d attachments, want 4", len(result.Attachments))
+	}
+
+	// the confirmed attachment with the lowest confirmation index wins, conflicting attachments are ignored
+	if result.ConfirmedTailTxHash != confirmedFirst || result.ConfirmationIndex != 3 {
+		t.Fatalf("got confirmed tail %s at %d, want %s at 3", result.ConfirmedTailTxHash, result.ConfirmationIndex, confirmedFirst)
+	}
+
+	for _, attachment := range result.Attachments {
+		switch attachment.TailTxHash {
+		case unconfirmed:
+			if attachment.Confirmed || attachment.ConfirmationIndex != 0 {
+				t.Fatalf("got unconfirmed attachment confirmed at %d", attachment.ConfirmationIndex)
+			}
+		case confirmedLate:
+			if !attachment.Confirmed || attachment.ConfirmationIndex != 5 {
+				t.Fatalf("got attachment confirmed at %d, want 5", attachment.ConfirmationIndex)
+			}
+		case conflicting:
+			if !attachment.Conflicting {
+				t.Fatal("got conflicting attachment that is not conflicting")
+			}
+		}
+
+		if !attachment.Complete || !attachment.Valid {
+			t.Fatalf("got incomplete or invalid attachment %s", attachment.TailTxHash)
+		}
+	}
+}
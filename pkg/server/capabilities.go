@@ -0,0 +1,70 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// FeatureWereAddressesSpentFrom is set if the spent addresses were tracked by the node that created the database.
+	FeatureWereAddressesSpentFrom = "WereAddressesSpentFrom"
+	// FeatureGraphQL is set if the GraphQL endpoint is enabled.
+	FeatureGraphQL = "GraphQL"
+)
+
+// features returns the optional features that are available in the API.
+func (s *DatabaseServer) features() []string {
+	features := []string{}
+
+	if s.Database.IsSpentAddressesEnabled() {
+		features = append(features, FeatureWereAddressesSpentFrom)
+	}
+
+	if s.GraphQLEnabled {
+		features = append(features, FeatureGraphQL)
+	}
+
+	return features
+}
+
+// rpcCommands returns the sorted names of the implemented RPC commands.
+func (s *DatabaseServer) rpcCommands() []string {
+	commands := make([]string, len(s.RPCCommands))
+	copy(commands, s.RPCCommands)
+	sort.Strings(commands)
+
+	return commands
+}
+
+func (s *DatabaseServer) rpcGetNodeAPIConfiguration(_ echo.Context) (any, error) {
+	syncState := s.Database.LatestSyncState()
+
+	return &GetNodeAPIConfigurationResponse{
+		MaxFindTransactions: s.RestAPILimitsMaxResults,
+		MaxRequestsList:     s.RestAPILimitsMaxResults,
+		MaxGetTrytes:        s.RestAPILimitsMaxResults,
+		MaxBodyLength:       s.RestAPILimitsMaxBodyLength,
+		MilestoneStartIndex: syncState.MilestoneStartIndex,
+		Features:            s.features(),
+		Commands:            s.rpcCommands(),
+	}, nil
+}
+
+//nolint:unparam // even if the error is never used, the structure of all routes should be the same
+func (s *DatabaseServer) capabilities() (*capabilitiesResponse, error) {
+	syncState := s.Database.LatestSyncState()
+
+	return &capabilitiesResponse{
+		AppName:               s.AppInfo.Name,
+		AppVersion:            s.AppInfo.Version,
+		MaxResults:            s.RestAPILimitsMaxResults,
+		MaxBodyLength:         s.RestAPILimitsMaxBodyLength,
+		MaxBatchSize:          s.RPCBatchMaxSize,
+		RPCCommands:           s.rpcCommands(),
+		Features:              s.features(),
+		SpentAddressesEnabled: s.Database.IsSpentAddressesEnabled(),
+		PruningIndex:          syncState.MilestoneStartIndex,
+		LedgerIndex:           s.Database.GetLedgerIndex(),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/iotaledger/hive.go/core/app"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/databasetest"
+)
+
+func TestCapabilities(t *testing.T) {
+	tests := []struct {
+		name                  string
+		spentAddressesEnabled bool
+		graphQLEnabled        bool
+		wantFeatures          []string
+	}{
+		{name: "no optional features", wantFeatures: []string{}},
+		{name: "spent addresses", spentAddressesEnabled: true, wantFeatures: []string{FeatureWereAddressesSpentFrom}},
+		{name: "all optional features", spentAddressesEnabled: true, graphQLEnabled: true, wantFeatures: []string{FeatureWereAddressesSpentFrom, FeatureGraphQL}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tangle := databasetest.New(t)
+			tangle.SetSnapshot(1, tt.spentAddressesEnabled)
+			ms1 := tangle.AddMilestone(1, databasetest.NullHash, databasetest.NullHash)
+			tangle.AddMilestone(2, ms1, ms1)
+			tangle.SetLedgerIndex(2)
+
+			s := &DatabaseServer{
+				AppInfo:                    &app.Info{Name: "inx-api-core-v0", Version: "1.0.0"},
+				Database:                   tangle.Database(),
+				RestAPILimitsMaxResults:    100,
+				RestAPILimitsMaxBodyLength: 1000,
+				RPCBatchMaxSize:            10,
+				GraphQLEnabled:             tt.graphQLEnabled,
+				RPCEndpoints:               make(map[string]rpcEndpoint),
+			}
+			s.configureRPCEndpoints()
+
+			// the commands are sorted, independent of the order they were registered in
+			wantCommands := []string{
+				"checkConsistency",
+				"findTransactions",
+				"getBalances",
+				"getFundsOnSpentAddresses",
+				"getInclusionStates",
+				"getLedgerDiff",
+				"getLedgerDiffExt",
+				"getLedgerState",
+				"getNodeAPIConfiguration",
+				"getNodeInfo",
+				"getTrytes",
+				"getWhiteFlagConfirmation",
+				"searchConfirmedApprover",
+				"wereAddressesSpentFrom",
+			}
+
+			capabilities, err := s.capabilities()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := &capabilitiesResponse{
+				AppName:               "inx-api-core-v0",
+				AppVersion:            "1.0.0",
+				MaxResults:            100,
+				MaxBodyLength:         1000,
+				MaxBatchSize:          10,
+				RPCCommands:           wantCommands,
+				Features:              tt.wantFeatures,
+				SpentAddressesEnabled: tt.spentAddressesEnabled,
+				PruningIndex:          1,
+				LedgerIndex:           2,
+			}
+			if !reflect.DeepEqual(capabilities, want) {
+				t.Fatalf("got capabilities %+v, want %+v", capabilities, want)
+			}
+
+			// the RPC command reports the same limits and features
+			resp, err := s.rpcGetNodeAPIConfiguration(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantConfiguration := &GetNodeAPIConfigurationResponse{
+				MaxFindTransactions: 100,
+				MaxRequestsList:     100,
+				MaxGetTrytes:        100,
+				MaxBodyLength:       1000,
+				MilestoneStartIndex: 1,
+				Features:            tt.wantFeatures,
+				Commands:            wantCommands,
+			}
+			if !reflect.DeepEqual(resp, wantConfiguration) {
+				t.Fatalf("got configuration %+v, want %+v", resp, wantConfiguration)
+			}
+		})
+	}
+}
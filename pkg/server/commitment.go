@@ -0,0 +1,88 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+// ledgerStateCommitment returns the commitment over the ledger state of the milestone given by the "index" path parameter.
+func (s *DatabaseServer) ledgerStateCommitment(c echo.Context) (*database.LedgerStateCommitment, error) {
+	msIndexIotaGo, err := httpserver.ParseMilestoneIndexParam(c, ParameterMilestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+	msIndex := milestone.Index(msIndexIotaGo)
+
+	smi := s.Database.GetSolidMilestoneIndex()
+	if msIndex > smi {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", msIndex, smi)
+	}
+
+	commitment, err := s.Database.GetLedgerStateCommitment(c.Request().Context(), msIndex)
+	if err != nil {
+		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	return commitment, nil
+}
+
+func (s *DatabaseServer) ledgerStateCommitmentByIndex(c echo.Context) (interface{}, error) {
+	commitment, err := s.ledgerStateCommitment(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ledgerStateCommitmentResponse{
+		Root:        commitment.Tree.Root().String(),
+		LeafCount:   commitment.Tree.LeafCount(),
+		LedgerIndex: commitment.MilestoneIndex,
+	}, nil
+}
+
+func (s *DatabaseServer) ledgerStateProofByIndex(c echo.Context) (interface{}, error) {
+	addr, err := parseAddressParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment, err := s.ledgerStateCommitment(c)
+	if err != nil {
+		return nil, err
+	}
+
+	addrTrytes := addr.Trytes()
+	leafIndex, exists := commitment.LeafIndex(addrTrytes)
+	if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "address has no balance at milestone %d: %s", commitment.MilestoneIndex, addrTrytes)
+	}
+
+	proof, err := commitment.Tree.Proof(leafIndex)
+	if err != nil {
+		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	path := make([]*merkleProofStep, 0, len(proof))
+	for _, step := range proof {
+		path = append(path, &merkleProofStep{
+			Hash:   step.Hash.String(),
+			IsLeft: step.IsLeft,
+		})
+	}
+
+	return &ledgerStateProofResponse{
+		Address:     addrTrytes,
+		Balance:     strconv.FormatUint(commitment.Balances[leafIndex], 10),
+		LeafIndex:   leafIndex,
+		LeafCount:   commitment.Tree.LeafCount(),
+		Path:        path,
+		Root:        commitment.Tree.Root().String(),
+		LedgerIndex: commitment.MilestoneIndex,
+	}, nil
+}
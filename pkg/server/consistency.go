@@ -0,0 +1,78 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/guards"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+)
+
+func (s *DatabaseServer) rpcCheckConsistency(c echo.Context) (interface{}, error) {
+	request := &CheckConsistency{}
+	if err := c.Bind(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	if len(request.Tails) == 0 {
+		return nil, errors.WithMessage(httpserver.ErrInvalidParameter, "invalid request, error: no tails provided")
+	}
+
+	for _, tail := range request.Tails {
+		if !guards.IsTransactionHash(tail) {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid tail hash provided: %s", tail)
+		}
+	}
+
+	ctx := c.Request().Context()
+
+	// unknown transactions and non-tails are invalid requests, like in IRI
+	tailsMeta := make([]*database.TransactionMetadata, 0, len(request.Tails))
+	for _, tail := range request.Tails {
+		txMeta := s.Database.GetTxMetadataOrNilContext(ctx, hornet.HashFromHashTrytes(tail))
+		if txMeta == nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "transaction not found: %s", tail)
+		}
+
+		if !txMeta.IsTail() {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid transaction, not a tail: %s", tail)
+		}
+
+		tailsMeta = append(tailsMeta, txMeta)
+	}
+
+	for _, txMeta := range tailsMeta {
+		bndl := s.Database.GetBundleOrNilContext(ctx, txMeta.GetTxHash())
+		if bndl == nil {
+			return &CheckConsistencyResponse{
+				State: false,
+				Info:  fmt.Sprintf("tails are not solid (missing a referenced tx): %s", txMeta.GetTxHash().Trytes()),
+			}, nil
+		}
+
+		if !bndl.IsValid() {
+			return &CheckConsistencyResponse{
+				State: false,
+				Info:  fmt.Sprintf("tails are not consistent (bundle is invalid): %s", txMeta.GetTxHash().Trytes()),
+			}, nil
+		}
+
+		// the ledger doesn't change anymore, so tails that were not confirmed can never become consistent
+		if txMeta.IsConflicting() || !txMeta.IsConfirmed() {
+			return &CheckConsistencyResponse{
+				State: false,
+				Info:  fmt.Sprintf("tails are not consistent (would lead to inconsistent ledger state or below max depth): %s", txMeta.GetTxHash().Trytes()),
+			}, nil
+		}
+	}
+
+	return &CheckConsistencyResponse{
+		State: true,
+		Info:  "",
+	}, nil
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/databasetest"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+func TestRPCCheckConsistency(t *testing.T) {
+	tangle := databasetest.New(t)
+
+	addTail := func(confirmationIndex milestone.Index, withBundle bool, validBundle bool) trinary.Hash {
+		tx := tangle.NewTransaction(databasetest.NullHash, databasetest.NullHash)
+		txHash := tangle.AddTransaction(tx, confirmationIndex)
+		if withBundle {
+			tangle.AddBundle([]*transaction.Transaction{tx}, validBundle, nil)
+		}
+
+		return txHash
+	}
+
+	consistent := addTail(1, true, true)
+	unconfirmed := addTail(0, true, true)
+	conflicting := addTail(1, true, true)
+	tangle.MarkConflicting(conflicting)
+	missingBundle := addTail(1, false, false)
+	invalidBundle := addTail(1, true, false)
+
+	nonTailTx := tangle.NewTransaction(databasetest.NullHash, databasetest.NullHash)
+	nonTailTx.CurrentIndex = 1
+	nonTailTx.LastIndex = 1
+	nonTail := tangle.AddTransaction(nonTailTx, 1)
+
+	tests := []struct {
+		name         string
+		tails        []trinary.Hash
+		wantState    bool
+		wantInfo     string
+		wantParamErr bool
+	}{
+		{name: "consistent tails", tails: []trinary.Hash{consistent, consistent}, wantState: true},
+		{name: "missing bundle", tails: []trinary.Hash{consistent, missingBundle}, wantInfo: "tails are not solid"},
+		{name: "invalid bundle", tails: []trinary.Hash{invalidBundle}, wantInfo: "bundle is invalid"},
+		{name: "unconfirmed tail", tails: []trinary.Hash{unconfirmed}, wantInfo: "would lead to inconsistent ledger state"},
+		{name: "conflicting tail", tails: []trinary.Hash{conflicting}, wantInfo: "would lead to inconsistent ledger state"},
+		{name: "no tails", tails: []trinary.Hash{}, wantParamErr: true},
+		{name: "invalid hash", tails: []trinary.Hash{"A"}, wantParamErr: true},
+		{name: "unknown transaction", tails: []trinary.Hash{strings.Repeat("A", len(consistent))}, wantParamErr: true},
+		{name: "not a tail", tails: []trinary.Hash{nonTail}, wantParamErr: true},
+	}
+
+	s := &DatabaseServer{Database: tangle.Database()}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(&CheckConsistency{Tails: tt.tails})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := s.rpcCheckConsistency(newRouteTestContext(http.MethodPost, RouteRPCEndpoint, string(body), nil))
+			if tt.wantParamErr {
+				if !errors.Is(err, httpserver.ErrInvalidParameter) {
+					t.Fatalf("got error %v, want %v", err, httpserver.ErrInvalidParameter)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := resp.(*CheckConsistencyResponse)
+			if result.State != tt.wantState || !strings.Contains(result.Info, tt.wantInfo) {
+				t.Fatalf("got state %t with info %q, want %t with %q", result.State, result.Info, tt.wantState, tt.wantInfo)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+)
+
+// balanceDistribution returns the balance distribution of the milestone given by the "index" query parameter.
+func (s *DatabaseServer) balanceDistribution(c echo.Context) (*database.BalanceDistribution, error) {
+	msIndex, err := parseMilestoneIndexQueryParam(c, QueryParameterIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if msIndex == 0 {
+		msIndex = s.Database.GetSolidMilestoneIndex()
+	}
+
+	// the ledger state is computed by rolling back the ledger diffs, so it is available for the same milestones
+	if err := s.checkLedgerDiffIndex(msIndex); err != nil {
+		return nil, err
+	}
+
+	distribution, err := s.Database.GetBalanceDistribution(c.Request().Context(), msIndex)
+	if err != nil {
+		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	return distribution, nil
+}
+
+func (s *DatabaseServer) ledgerTopHolders(c echo.Context) (interface{}, error) {
+	limit, err := parseLimitQueryParam(c, s.RestAPILimitsMaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := parseBoolQueryParam(c, QueryParameterChecksum)
+	if err != nil {
+		return nil, err
+	}
+
+	distribution, err := s.balanceDistribution(c)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := distribution.Holders
+	if len(holders) > limit {
+		holders = holders[:limit]
+	}
+
+	addresses := make([]*addressWithBalance, 0, len(holders))
+	for _, holder := range holders {
+		addresses = append(addresses, &addressWithBalance{
+			Address: addressWithChecksum(holder.Address.Trytes(), checksum),
+			Balance: strconv.FormatUint(holder.Balance, 10),
+		})
+	}
+
+	return &topHoldersResponse{
+		Addresses:    addresses,
+		TotalBalance: strconv.FormatUint(distribution.TotalBalance, 10),
+		TotalCount:   len(distribution.Holders),
+		LedgerIndex:  distribution.MilestoneIndex,
+	}, nil
+}
+
+func (s *DatabaseServer) ledgerDistribution(c echo.Context) (interface{}, error) {
+	distribution, err := s.balanceDistribution(c)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]*balanceBucket, 0, len(distribution.Buckets))
+	for _, bucket := range distribution.Buckets {
+		buckets = append(buckets, &balanceBucket{
+			MinBalance: strconv.FormatUint(bucket.MinBalance, 10),
+			MaxBalance: strconv.FormatUint(bucket.MaxBalance, 10),
+			Addresses:  bucket.Addresses,
+			Balance:    strconv.FormatUint(bucket.Balance, 10),
+		})
+	}
+
+	return &distributionResponse{
+		Buckets:      buckets,
+		TotalBalance: strconv.FormatUint(distribution.TotalBalance, 10),
+		TotalCount:   len(distribution.Holders),
+		Gini:         distribution.Gini,
+		LedgerIndex:  distribution.MilestoneIndex,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+)
+
+func TestBalanceDistributionRejectsUnavailableMilestones(t *testing.T) {
+	tests := []struct {
+		name  string
+		index string
+	}{
+		{name: "milestone is the pruning index", index: "1"},
+		{name: "milestone is above the latest solid milestone", index: "4"},
+	}
+
+	s := newTestDatabaseServer(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+QueryParameterIndex+"="+tt.index, nil)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			if _, err := s.balanceDistribution(c); !errors.Is(err, httpserver.ErrInvalidParameter) {
+				t.Fatalf("got error %v, want %v", err, httpserver.ErrInvalidParameter)
+			}
+		})
+	}
+}
@@ -0,0 +1,574 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/labstack/echo/v4"
+	"github.com/pangpanglabs/echoswagger/v2"
+	"github.com/pkg/errors"
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/address"
+	"github.com/iotaledger/iota.go/guards"
+	"github.com/iotaledger/iota.go/transaction"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+const graphQLSchema = `
+schema {
+	query: Query
+}
+
+type Query {
+	transaction(hash: String!): Transaction
+	bundle(tailHash: String!): Bundle
+	address(address: String!): Address!
+	milestone(index: Int!): Milestone
+	latestMilestone: Milestone!
+	ledgerDiff(index: Int!): LedgerDiff!
+}
+
+type Transaction {
+	hash: String!
+	trytes: String!
+	address: Address!
+	value: String!
+	tag: String!
+	obsoleteTag: String!
+	timestamp: String!
+	currentIndex: Int!
+	lastIndex: Int!
+	bundleHash: String!
+	bundle: Bundle
+	trunk: Transaction
+	branch: Transaction
+	approvers(first: Int): [Transaction!]!
+	confirmed: Boolean!
+	conflicting: Boolean!
+	confirmationIndex: Int
+}
+
+type Bundle {
+	hash: String!
+	tailHash: String!
+	lastIndex: Int!
+	valid: Boolean!
+	valueSpam: Boolean!
+	tail: Transaction!
+	transactions: [Transaction!]!
+	ledgerChanges: [AddressChange!]!
+}
+
+type Address {
+	address: String!
+	balance: String!
+	wasSpent: Boolean!
+	transactions(first: Int, valueOnly: Boolean): [Transaction!]!
+}
+
+type Milestone {
+	index: Int!
+	hash: String!
+	bundle: Bundle!
+	ledgerDiff: LedgerDiff!
+}
+
+type LedgerDiff {
+	milestoneIndex: Int!
+	changes(first: Int): [AddressChange!]!
+}
+
+type AddressChange {
+	address: Address!
+	change: String!
+}
+`
+
+const (
+	// graphQLMaxLedgerDiffs is the maximum amount of ledger diffs a single GraphQL query may resolve.
+	// Every ledger diff iterates over all changes of the milestone, regardless of the requested amount of changes.
+	graphQLMaxLedgerDiffs = 5
+)
+
+var (
+	// ErrGraphQLComplexityExceeded is returned if a GraphQL query resolves more objects than allowed.
+	ErrGraphQLComplexityExceeded = errors.New("query complexity exceeded")
+)
+
+// graphQLRequest struct.
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type graphQLBudgetContextKey struct{}
+
+// graphQLBudget limits the amount of objects and ledger diffs a single GraphQL query may resolve.
+type graphQLBudget struct {
+	remaining   *atomic.Int64
+	ledgerDiffs *atomic.Int64
+}
+
+func withGraphQLBudget(ctx context.Context, budget int) context.Context {
+	return context.WithValue(ctx, graphQLBudgetContextKey{}, &graphQLBudget{
+		remaining:   atomic.NewInt64(int64(budget)),
+		ledgerDiffs: atomic.NewInt64(0),
+	})
+}
+
+// consumeGraphQLBudget consumes the given amount of objects from the budget of the query.
+func consumeGraphQLBudget(ctx context.Context, amount int) error {
+	budget, ok := ctx.Value(graphQLBudgetContextKey{}).(*graphQLBudget)
+	if !ok {
+		return nil
+	}
+
+	if budget.remaining.Sub(int64(amount)) < 0 {
+		return ErrGraphQLComplexityExceeded
+	}
+
+	return nil
+}
+
+// consumeGraphQLLedgerDiff consumes a ledger diff from the budget of the query.
+// The ledger diff also counts as a resolved object.
+func consumeGraphQLLedgerDiff(ctx context.Context) error {
+	budget, ok := ctx.Value(graphQLBudgetContextKey{}).(*graphQLBudget)
+	if !ok {
+		return nil
+	}
+
+	if budget.ledgerDiffs.Inc() > graphQLMaxLedgerDiffs {
+		return ErrGraphQLComplexityExceeded
+	}
+
+	return consumeGraphQLBudget(ctx, 1)
+}
+
+// newGraphQLSchema returns the schema of the GraphQL endpoint, which rejects queries that are nested deeper than the given depth.
+func (s *DatabaseServer) newGraphQLSchema(maxDepth int) *graphql.Schema {
+	return graphql.MustParseSchema(graphQLSchema, &graphQLResolver{s: s}, graphql.MaxDepth(maxDepth))
+}
+
+func (s *DatabaseServer) configureGraphQLRoute(routeGroup echoswagger.ApiGroup, maxDepth int) {
+	schema := s.newGraphQLSchema(maxDepth)
+
+	routeGroup.POST(RouteGraphQL, func(c echo.Context) error {
+		request := &graphQLRequest{}
+		if err := c.Bind(request); err != nil {
+			return errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+		}
+
+		// every resolved object counts against the maximum results of the API
+		ctx := withGraphQLBudget(c.Request().Context(), s.RestAPILimitsMaxResults)
+
+		return httpserver.JSONResponse(c, http.StatusOK, schema.Exec(ctx, request.Query, request.OperationName, request.Variables))
+	}).
+		SetDescription("the route for sending GraphQL queries to the API").
+		SetOperationId("graphql").
+		AddParamBody(graphQLRequest{}, "", "the GraphQL query", true)
+}
+
+// limitFromFirst returns the amount of requested list items, bounded by the maximum results of the API.
+func (s *DatabaseServer) limitFromFirst(first *int32) int {
+	if first != nil && *first > 0 && int(*first) < s.RestAPILimitsMaxResults {
+		return int(*first)
+	}
+
+	return s.RestAPILimitsMaxResults
+}
+
+type graphQLResolver struct {
+	s *DatabaseServer
+}
+
+func (r *graphQLResolver) Transaction(ctx context.Context, args struct{ Hash string }) (*graphQLTransaction, error) {
+	txHash := strings.ToUpper(args.Hash)
+	if !guards.IsTransactionHash(txHash) {
+		return nil, fmt.Errorf("invalid transaction hash provided: %s", txHash)
+	}
+
+	return r.s.graphQLTransactionOrNil(ctx, hornet.HashFromHashTrytes(txHash))
+}
+
+func (r *graphQLResolver) Bundle(ctx context.Context, args struct{ TailHash string }) (*graphQLBundle, error) {
+	tailTxHash := strings.ToUpper(args.TailHash)
+	if !guards.IsTransactionHash(tailTxHash) {
+		return nil, fmt.Errorf("invalid tail transaction hash provided: %s", tailTxHash)
+	}
+
+	return r.s.graphQLBundleOrNil(ctx, hornet.HashFromHashTrytes(tailTxHash))
+}
+
+func (r *graphQLResolver) Address(args struct{ Address string }) (*graphQLAddress, error) {
+	addr := strings.ToUpper(args.Address)
+	if err := address.ValidAddress(addr); err != nil {
+		return nil, fmt.Errorf("invalid address hash provided: %s, error: %w", addr, err)
+	}
+
+	return &graphQLAddress{s: r.s, address: hornet.HashFromAddressTrytes(addr)}, nil
+}
+
+func (r *graphQLResolver) Milestone(ctx context.Context, args struct{ Index int32 }) (*graphQLMilestone, error) {
+	if args.Index <= 0 {
+		return nil, fmt.Errorf("invalid milestone index: %d", args.Index)
+	}
+
+	ms := r.s.Database.GetMilestoneOrNilContext(ctx, milestone.Index(args.Index))
+	if ms == nil {
+		return nil, nil
+	}
+
+	return &graphQLMilestone{s: r.s, milestone: ms}, nil
+}
+
+func (r *graphQLResolver) LatestMilestone(ctx context.Context) (*graphQLMilestone, error) {
+	ms := r.s.Database.GetMilestoneOrNilContext(ctx, r.s.Database.GetSolidMilestoneIndex())
+	if ms == nil {
+		return nil, fmt.Errorf("latest solid milestone not found: %d", r.s.Database.GetSolidMilestoneIndex())
+	}
+
+	return &graphQLMilestone{s: r.s, milestone: ms}, nil
+}
+
+func (r *graphQLResolver) LedgerDiff(args struct{ Index int32 }) (*graphQLLedgerDiff, error) {
+	if args.Index < 0 {
+		return nil, fmt.Errorf("invalid milestone index: %d", args.Index)
+	}
+
+	return r.s.graphQLLedgerDiff(milestone.Index(args.Index))
+}
+
+func (s *DatabaseServer) graphQLTransactionOrNil(ctx context.Context, txHash hornet.Hash) (*graphQLTransaction, error) {
+	if err := consumeGraphQLBudget(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	tx := s.Database.GetTransactionOrNilContext(ctx, txHash)
+	if tx == nil {
+		return nil, nil
+	}
+
+	return &graphQLTransaction{s: s, tx: tx}, nil
+}
+
+func (s *DatabaseServer) graphQLBundleOrNil(ctx context.Context, tailTxHash hornet.Hash) (*graphQLBundle, error) {
+	if err := consumeGraphQLBudget(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	bndl := s.Database.GetBundleOrNilContext(ctx, tailTxHash)
+	if bndl == nil {
+		return nil, nil
+	}
+
+	return &graphQLBundle{s: s, bundle: bndl}, nil
+}
+
+func (s *DatabaseServer) graphQLTransactions(ctx context.Context, txHashes hornet.Hashes) ([]*graphQLTransaction, error) {
+	txs := make([]*graphQLTransaction, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		tx, err := s.graphQLTransactionOrNil(ctx, txHash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+type graphQLTransaction struct {
+	s  *DatabaseServer
+	tx *database.Transaction
+}
+
+func (t *graphQLTransaction) Hash() string {
+	return t.tx.Tx.Hash
+}
+
+func (t *graphQLTransaction) Trytes() (string, error) {
+	return transaction.TransactionToTrytes(t.tx.Tx)
+}
+
+func (t *graphQLTransaction) Address() *graphQLAddress {
+	return &graphQLAddress{s: t.s, address: hornet.HashFromAddressTrytes(t.tx.Tx.Address)}
+}
+
+func (t *graphQLTransaction) Value() string {
+	return strconv.FormatInt(t.tx.Tx.Value, 10)
+}
+
+func (t *graphQLTransaction) Tag() string {
+	return t.tx.Tx.Tag
+}
+
+func (t *graphQLTransaction) ObsoleteTag() string {
+	return t.tx.Tx.ObsoleteTag
+}
+
+func (t *graphQLTransaction) Timestamp() string {
+	return strconv.FormatUint(t.tx.Tx.Timestamp, 10)
+}
+
+func (t *graphQLTransaction) CurrentIndex() int32 {
+	return int32(t.tx.Tx.CurrentIndex)
+}
+
+func (t *graphQLTransaction) LastIndex() int32 {
+	return int32(t.tx.Tx.LastIndex)
+}
+
+func (t *graphQLTransaction) BundleHash() string {
+	return t.tx.Tx.Bundle
+}
+
+func (t *graphQLTransaction) Bundle(ctx context.Context) (*graphQLBundle, error) {
+	if !t.tx.IsTail() {
+		// bundles are only stored by their tail transaction
+		return nil, nil
+	}
+
+	return t.s.graphQLBundleOrNil(ctx, hornet.HashFromHashTrytes(t.tx.Tx.Hash))
+}
+
+func (t *graphQLTransaction) Trunk(ctx context.Context) (*graphQLTransaction, error) {
+	return t.s.graphQLTransactionOrNil(ctx, t.tx.GetTrunkHash())
+}
+
+func (t *graphQLTransaction) Branch(ctx context.Context) (*graphQLTransaction, error) {
+	return t.s.graphQLTransactionOrNil(ctx, t.tx.GetBranchHash())
+}
+
+func (t *graphQLTransaction) Approvers(ctx context.Context, args struct{ First *int32 }) ([]*graphQLTransaction, error) {
+	return t.s.graphQLTransactions(ctx, t.s.Database.GetApproverHashesContext(ctx, hornet.HashFromHashTrytes(t.tx.Tx.Hash), t.s.limitFromFirst(args.First)))
+}
+
+func (t *graphQLTransaction) metadata(ctx context.Context) *database.TransactionMetadata {
+	return t.s.Database.GetTxMetadataOrNilContext(ctx, hornet.HashFromHashTrytes(t.tx.Tx.Hash))
+}
+
+func (t *graphQLTransaction) Confirmed(ctx context.Context) bool {
+	txMeta := t.metadata(ctx)
+
+	return txMeta != nil && txMeta.IsConfirmed()
+}
+
+func (t *graphQLTransaction) Conflicting(ctx context.Context) bool {
+	txMeta := t.metadata(ctx)
+
+	return txMeta != nil && txMeta.IsConflicting()
+}
+
+func (t *graphQLTransaction) ConfirmationIndex(ctx context.Context) *int32 {
+	txMeta := t.metadata(ctx)
+	if txMeta == nil {
+		return nil
+	}
+
+	confirmed, at := txMeta.GetConfirmed()
+	if !confirmed {
+		return nil
+	}
+
+	index := int32(at)
+
+	return &index
+}
+
+type graphQLBundle struct {
+	s      *DatabaseServer
+	bundle *database.Bundle
+}
+
+func (b *graphQLBundle) Hash() string {
+	return b.bundle.GetTail().Tx.Bundle
+}
+
+func (b *graphQLBundle) TailHash() string {
+	return b.bundle.GetTailHash().Trytes()
+}
+
+func (b *graphQLBundle) LastIndex() int32 {
+	return int32(b.bundle.GetTail().Tx.LastIndex)
+}
+
+func (b *graphQLBundle) Valid() bool {
+	return b.bundle.IsValid()
+}
+
+func (b *graphQLBundle) ValueSpam() bool {
+	return b.bundle.IsValueSpam()
+}
+
+func (b *graphQLBundle) Tail() *graphQLTransaction {
+	return &graphQLTransaction{s: b.s, tx: b.bundle.GetTail()}
+}
+
+func (b *graphQLBundle) Transactions(ctx context.Context) ([]*graphQLTransaction, error) {
+	txs := b.bundle.GetTransactions()
+	if err := consumeGraphQLBudget(ctx, len(txs)); err != nil {
+		return nil, err
+	}
+
+	result := make([]*graphQLTransaction, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, &graphQLTransaction{s: b.s, tx: tx})
+	}
+
+	return result, nil
+}
+
+func (b *graphQLBundle) LedgerChanges(ctx context.Context) ([]*graphQLAddressChange, error) {
+	return b.s.graphQLAddressChanges(ctx, b.bundle.GetLedgerChanges(), len(b.bundle.GetLedgerChanges()))
+}
+
+type graphQLAddress struct {
+	s       *DatabaseServer
+	address hornet.Hash
+}
+
+func (a *graphQLAddress) Address() string {
+	return a.address.Trytes()
+}
+
+func (a *graphQLAddress) Balance(ctx context.Context) (string, error) {
+	if err := consumeGraphQLBudget(ctx, 1); err != nil {
+		return "", err
+	}
+
+	balance, _, err := a.s.Database.GetBalanceForAddressContext(ctx, a.address)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(balance, 10), nil
+}
+
+func (a *graphQLAddress) WasSpent(ctx context.Context) (bool, error) {
+	if err := consumeGraphQLBudget(ctx, 1); err != nil {
+		return false, err
+	}
+
+	return a.s.Database.WasAddressSpentFromContext(ctx, a.address), nil
+}
+
+func (a *graphQLAddress) Transactions(ctx context.Context, args struct {
+	First     *int32
+	ValueOnly *bool
+}) ([]*graphQLTransaction, error) {
+	valueOnly := args.ValueOnly != nil && *args.ValueOnly
+
+	return a.s.graphQLTransactions(ctx, a.s.Database.GetTransactionHashesForAddressContext(ctx, a.address, valueOnly, true, a.s.limitFromFirst(args.First)))
+}
+
+type graphQLMilestone struct {
+	s         *DatabaseServer
+	milestone *database.Milestone
+}
+
+func (m *graphQLMilestone) Index() int32 {
+	return int32(m.milestone.Index)
+}
+
+func (m *graphQLMilestone) Hash() string {
+	return m.milestone.Hash.Trytes()
+}
+
+func (m *graphQLMilestone) Bundle(ctx context.Context) (*graphQLBundle, error) {
+	bndl, err := m.s.graphQLBundleOrNil(ctx, m.milestone.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if bndl == nil {
+		return nil, fmt.Errorf("milestone bundle not found: %d", m.milestone.Index)
+	}
+
+	return bndl, nil
+}
+
+func (m *graphQLMilestone) LedgerDiff() (*graphQLLedgerDiff, error) {
+	return m.s.graphQLLedgerDiff(m.milestone.Index)
+}
+
+func (s *DatabaseServer) graphQLLedgerDiff(msIndex milestone.Index) (*graphQLLedgerDiff, error) {
+	if err := s.checkLedgerDiffIndex(msIndex); err != nil {
+		return nil, err
+	}
+
+	return &graphQLLedgerDiff{s: s, milestoneIndex: msIndex}, nil
+}
+
+type graphQLLedgerDiff struct {
+	s              *DatabaseServer
+	milestoneIndex milestone.Index
+}
+
+func (d *graphQLLedgerDiff) MilestoneIndex() int32 {
+	return int32(d.milestoneIndex)
+}
+
+func (d *graphQLLedgerDiff) Changes(ctx context.Context, args struct{ First *int32 }) ([]*graphQLAddressChange, error) {
+	if err := consumeGraphQLLedgerDiff(ctx); err != nil {
+		return nil, err
+	}
+
+	diff, err := d.s.Database.GetLedgerDiffForMilestone(ctx, d.milestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.s.graphQLAddressChanges(ctx, diff, d.s.limitFromFirst(args.First))
+}
+
+func (s *DatabaseServer) graphQLAddressChanges(ctx context.Context, changes map[string]int64, limit int) ([]*graphQLAddressChange, error) {
+	if limit > len(changes) {
+		limit = len(changes)
+	}
+
+	if err := consumeGraphQLBudget(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	// sort the addresses to return stable results
+	addresses := make([]string, 0, len(changes))
+	for addr := range changes {
+		addresses = append(addresses, addr)
+	}
+	sort.Strings(addresses)
+
+	result := make([]*graphQLAddressChange, 0, limit)
+	for _, addr := range addresses[:limit] {
+		result = append(result, &graphQLAddressChange{s: s, address: hornet.Hash(addr), change: changes[addr]})
+	}
+
+	return result, nil
+}
+
+type graphQLAddressChange struct {
+	s       *DatabaseServer
+	address hornet.Hash
+	change  int64
+}
+
+func (c *graphQLAddressChange) Address() *graphQLAddress {
+	return &graphQLAddress{s: c.s, address: c.address}
+}
+
+func (c *graphQLAddressChange) Change() string {
+	return strconv.FormatInt(c.change, 10)
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+)
+
+func TestGraphQLQueries(t *testing.T) {
+	addressA := strings.Repeat("A", consts.HashTrytesSize)
+
+	ledgerDiffs := func(count int) string {
+		var query strings.Builder
+		query.WriteString("{")
+		for i := 0; i < count; i++ {
+			fmt.Fprintf(&query, "d%d: ledgerDiff(index: 3) { changes(first: 1) { change } } ", i)
+		}
+		query.WriteString("}")
+
+		return query.String()
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		maxDepth  int
+		budget    int
+		wantError string
+	}{
+		{
+			name:     "query within the limits",
+			query:    `{ milestone(index: 3) { ledgerDiff { changes { change address { balance wasSpent } } } } }`,
+			maxDepth: 5,
+			budget:   100,
+		},
+		{
+			name:      "query exceeds the maximum depth",
+			query:     `{ latestMilestone { bundle { tail { trunk { trunk { hash } } } } } }`,
+			maxDepth:  4,
+			budget:    100,
+			wantError: "exceeds max depth",
+		},
+		{
+			name:      "query exceeds the maximum ledger diffs",
+			query:     ledgerDiffs(graphQLMaxLedgerDiffs + 1),
+			maxDepth:  5,
+			budget:    100,
+			wantError: ErrGraphQLComplexityExceeded.Error(),
+		},
+		{
+			name:     "query resolves the maximum ledger diffs",
+			query:    ledgerDiffs(graphQLMaxLedgerDiffs),
+			maxDepth: 5,
+			budget:   100,
+		},
+		{
+			name:      "address lookups are charged",
+			query:     fmt.Sprintf(`{ a: address(address: "%[1]s") { balance } b: address(address: "%[1]s") { wasSpent } }`, addressA),
+			maxDepth:  5,
+			budget:    1,
+			wantError: ErrGraphQLComplexityExceeded.Error(),
+		},
+		{
+			name:      "ledger diff of a pruned milestone",
+			query:     `{ ledgerDiff(index: 1) { milestoneIndex } }`,
+			maxDepth:  5,
+			budget:    100,
+			wantError: "pruning index is 1",
+		},
+		{
+			name:      "ledger diff of a pruned milestone by its milestone",
+			query:     `{ milestone(index: 1) { ledgerDiff { milestoneIndex } } }`,
+			maxDepth:  5,
+			budget:    100,
+			wantError: "pruning index is 1",
+		},
+		{
+			name:      "ledger diff above the latest solid milestone",
+			query:     `{ ledgerDiff(index: 4) { milestoneIndex } }`,
+			maxDepth:  5,
+			budget:    100,
+			wantError: "lsmi is 3",
+		},
+	}
+
+	s := newTestDatabaseServer(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := s.newGraphQLSchema(tt.maxDepth).Exec(withGraphQLBudget(context.Background(), tt.budget), tt.query, "", nil)
+
+			if tt.wantError == "" {
+				if len(response.Errors) > 0 {
+					t.Fatalf("got errors %v, want none", response.Errors)
+				}
+
+				return
+			}
+
+			for _, err := range response.Errors {
+				if strings.Contains(err.Message, tt.wantError) {
+					return
+				}
+			}
+			t.Fatalf("got errors %v, want %q", response.Errors, tt.wantError)
+		})
+	}
+}
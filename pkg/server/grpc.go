@@ -0,0 +1,271 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/iota.go/address"
+	"github.com/iotaledger/iota.go/guards"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/legacyapi"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+// GRPCServer implements the queries of the DatabaseServer as a gRPC service.
+type GRPCServer struct {
+	legacyapi.UnimplementedLegacyAPIServer
+
+	server *DatabaseServer
+}
+
+// NewGRPCServer returns a gRPC service that answers the queries with the given DatabaseServer,
+// so it shares the limits and the configuration of the REST API.
+func NewGRPCServer(server *DatabaseServer) *GRPCServer {
+	return &GRPCServer{
+		server: server,
+	}
+}
+
+func grpcErrorFromDatabaseError(err error) error {
+	if errors.Is(err, database.ErrOperationAborted) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func validateGRPCAddresses(addresses []trinary.Hash) error {
+	if len(addresses) == 0 {
+		return status.Error(codes.InvalidArgument, "no addresses provided")
+	}
+
+	for _, addr := range addresses {
+		if err := address.ValidAddress(addr); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid address hash provided: %s", addr)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCTransactionHashes(txHashes []trinary.Hash) error {
+	for _, txHash := range txHashes {
+		if !guards.IsTransactionHash(txHash) {
+			return status.Errorf(codes.InvalidArgument, "invalid transaction hash provided: %s", txHash)
+		}
+	}
+
+	return nil
+}
+
+func (g *GRPCServer) GetNodeInfo(_ context.Context, _ *legacyapi.NoParams) (*legacyapi.NodeInfo, error) {
+	syncState := g.server.Database.LatestSyncState()
+
+	return &legacyapi.NodeInfo{
+		AppName:                            g.server.AppInfo.Name,
+		AppVersion:                         g.server.AppInfo.Version,
+		LatestMilestone:                    syncState.LatestMilestone,
+		LatestMilestoneIndex:               uint32(syncState.LatestMilestoneIndex),
+		LatestSolidSubtangleMilestone:      syncState.LatestSolidSubtangleMilestone,
+		LatestSolidSubtangleMilestoneIndex: uint32(syncState.LatestSolidSubtangleMilestoneIndex),
+		MilestoneStartIndex:                uint32(syncState.MilestoneStartIndex),
+		LastSnapshottedMilestoneIndex:      uint32(syncState.LastSnapshottedMilestoneIndex),
+		CoordinatorAddress:                 syncState.CoordinatorAddress,
+	}, nil
+}
+
+func (g *GRPCServer) GetBalances(ctx context.Context, req *legacyapi.GetBalancesRequest) (*legacyapi.GetBalancesResponse, error) {
+	if err := validateGRPCAddresses(req.GetAddresses()); err != nil {
+		return nil, err
+	}
+
+	result := &legacyapi.GetBalancesResponse{}
+
+	for _, addr := range req.GetAddresses() {
+		balance, _, err := g.server.Database.GetBalanceForAddressContext(ctx, hornet.HashFromAddressTrytes(addr))
+		if err != nil {
+			return nil, grpcErrorFromDatabaseError(err)
+		}
+
+		result.Balances = append(result.Balances, balance)
+	}
+
+	latestSolidMilestoneBundle := g.server.Database.GetLatestSolidMilestoneBundle()
+
+	// The index of the milestone that confirmed the most recent balance
+	result.MilestoneIndex = uint32(latestSolidMilestoneBundle.GetMilestoneIndex())
+	result.Reference = latestSolidMilestoneBundle.GetMilestoneHash().Trytes()
+
+	return result, nil
+}
+
+func (g *GRPCServer) WereAddressesSpentFrom(ctx context.Context, req *legacyapi.WereAddressesSpentFromRequest) (*legacyapi.WereAddressesSpentFromResponse, error) {
+	if err := validateGRPCAddresses(req.GetAddresses()); err != nil {
+		return nil, err
+	}
+
+	result := &legacyapi.WereAddressesSpentFromResponse{}
+	for _, addr := range req.GetAddresses() {
+		result.States = append(result.States, g.server.Database.WasAddressSpentFromContext(ctx, hornet.HashFromAddressTrytes(addr)))
+	}
+
+	return result, nil
+}
+
+func (g *GRPCServer) GetInclusionStates(ctx context.Context, req *legacyapi.GetInclusionStatesRequest) (*legacyapi.GetInclusionStatesResponse, error) {
+	if err := validateGRPCTransactionHashes(req.GetTransactions()); err != nil {
+		return nil, err
+	}
+
+	result := &legacyapi.GetInclusionStatesResponse{}
+	for _, tx := range req.GetTransactions() {
+		txMeta := g.server.Database.GetTxMetadataOrNilContext(ctx, hornet.HashFromHashTrytes(tx))
+
+		// avoid passing true for conflicting tx to be backwards compatible
+		result.States = append(result.States, txMeta != nil && txMeta.IsConfirmed() && !txMeta.IsConflicting())
+	}
+
+	return result, nil
+}
+
+func (g *GRPCServer) GetTrytes(ctx context.Context, req *legacyapi.GetTrytesRequest) (*legacyapi.GetTrytesResponse, error) {
+	if len(req.GetHashes()) > g.server.RestAPILimitsMaxResults {
+		return nil, status.Errorf(codes.InvalidArgument, "too many hashes. maximum allowed: %d", g.server.RestAPILimitsMaxResults)
+	}
+
+	if err := validateGRPCTransactionHashes(req.GetHashes()); err != nil {
+		return nil, err
+	}
+
+	result := &legacyapi.GetTrytesResponse{}
+	for _, hash := range req.GetHashes() {
+		tx := g.server.Database.GetTransactionOrNilContext(ctx, hornet.HashFromHashTrytes(hash))
+		if tx == nil {
+			result.Trytes = append(result.Trytes, strings.Repeat("9", 2673))
+
+			continue
+		}
+
+		txTrytes, err := transaction.TransactionToTrytes(tx.Tx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		result.Trytes = append(result.Trytes, txTrytes)
+	}
+
+	return result, nil
+}
+
+func (g *GRPCServer) FindTransactions(req *legacyapi.FindTransactionsRequest, stream legacyapi.LegacyAPI_FindTransactionsServer) error {
+	maxResults := g.server.RestAPILimitsMaxResults
+	if (req.GetMaxResults() > 0) && (int(req.GetMaxResults()) < maxResults) {
+		maxResults = int(req.GetMaxResults())
+	}
+
+	if len(req.GetBundles()) == 0 && len(req.GetAddresses()) == 0 && len(req.GetApprovees()) == 0 && len(req.GetTags()) == 0 {
+		return status.Error(codes.InvalidArgument, "no search criteria was given")
+	}
+
+	queryBundleHashes := make(map[string]struct{})
+	queryApproveeHashes := make(map[string]struct{})
+	queryAddressHashes := make(map[string]struct{})
+	queryTagHashes := make(map[string]struct{})
+
+	for _, bundleTrytes := range req.GetBundles() {
+		if !guards.IsTransactionHash(bundleTrytes) {
+			return status.Errorf(codes.InvalidArgument, "invalid bundle hash provided: %s", bundleTrytes)
+		}
+		queryBundleHashes[string(hornet.HashFromHashTrytes(bundleTrytes))] = struct{}{}
+	}
+
+	for _, approveeTrytes := range req.GetApprovees() {
+		if !guards.IsTransactionHash(approveeTrytes) {
+			return status.Errorf(codes.InvalidArgument, "invalid approvee hash provided: %s", approveeTrytes)
+		}
+		queryApproveeHashes[string(hornet.HashFromHashTrytes(approveeTrytes))] = struct{}{}
+	}
+
+	for _, addressTrytes := range req.GetAddresses() {
+		if err := address.ValidAddress(addressTrytes); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid address hash provided: %s", addressTrytes)
+		}
+		queryAddressHashes[string(hornet.HashFromAddressTrytes(addressTrytes))] = struct{}{}
+	}
+
+	for _, tagTrytes := range req.GetTags() {
+		if err := trinary.ValidTrytes(tagTrytes); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid tag trytes provided: %s", tagTrytes)
+		}
+		if len(tagTrytes) > 27 {
+			return status.Errorf(codes.InvalidArgument, "invalid tag length: %s", tagTrytes)
+		}
+		if len(tagTrytes) < 27 {
+			tagTrytes = trinary.MustPad(tagTrytes, 27)
+		}
+		queryTagHashes[string(hornet.HashFromTagTrytes(tagTrytes))] = struct{}{}
+	}
+
+	for _, txHash := range g.server.findTransactions(stream.Context(), maxResults, req.GetValueOnly(), queryBundleHashes, queryApproveeHashes, queryAddressHashes, queryTagHashes) {
+		if err := stream.Send(&legacyapi.TransactionHash{Hash: txHash}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *GRPCServer) GetLedgerState(req *legacyapi.GetLedgerStateRequest, stream legacyapi.LegacyAPI_GetLedgerStateServer) error {
+	targetIndex := milestone.Index(req.GetTargetIndex())
+	if targetIndex == 0 {
+		targetIndex = g.server.Database.GetSolidMilestoneIndex()
+	}
+
+	// the balances are sent while iterating the ledger, so the ledger state is never held in memory
+	var sendErr error
+	_, err := g.server.Database.ForEachBalanceForMilestone(stream.Context(), targetIndex, func(address hornet.Hash, balance uint64) bool {
+		sendErr = stream.Send(&legacyapi.AddressBalance{
+			Address:     address.Trytes(),
+			Balance:     balance,
+			LedgerIndex: uint32(targetIndex),
+		})
+
+		return sendErr == nil
+	})
+	if err != nil {
+		return grpcErrorFromDatabaseError(err)
+	}
+
+	return sendErr
+}
+
+func (g *GRPCServer) GetLedgerDiff(req *legacyapi.GetLedgerDiffRequest, stream legacyapi.LegacyAPI_GetLedgerDiffServer) error {
+	requestedIndex := milestone.Index(req.GetMilestoneIndex())
+	if err := g.server.checkLedgerDiffIndex(requestedIndex); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the changes are sent while iterating the diff, so the diff is never held in memory
+	var sendErr error
+	if err := g.server.Database.ForEachLedgerDiffChange(stream.Context(), requestedIndex, func(address hornet.Hash, change int64) bool {
+		sendErr = stream.Send(&legacyapi.AddressDiff{
+			Address:        address.Trytes(),
+			Diff:           change,
+			MilestoneIndex: uint32(requestedIndex),
+		})
+
+		return sendErr == nil
+	}); err != nil {
+		return grpcErrorFromDatabaseError(err)
+	}
+
+	return sendErr
+}
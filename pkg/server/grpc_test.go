@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/legacyapi"
+)
+
+type ledgerDiffStream struct {
+	grpc.ServerStream
+
+	diffs   []*legacyapi.AddressDiff
+	sendErr error
+}
+
+func (s *ledgerDiffStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *ledgerDiffStream) Send(diff *legacyapi.AddressDiff) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.diffs = append(s.diffs, diff)
+
+	return nil
+}
+
+func TestGRPCGetLedgerDiff(t *testing.T) {
+	errSend := errors.New("send failed")
+
+	tests := []struct {
+		name           string
+		milestoneIndex uint32
+		sendErr        error
+		wantDiffs      int
+		wantErr        error
+		wantCode       codes.Code
+	}{
+		{name: "changes are sent", milestoneIndex: 2, wantDiffs: 2},
+		{name: "send error stops the iteration", milestoneIndex: 2, sendErr: errSend, wantErr: errSend},
+		{name: "pruned milestone", milestoneIndex: 1, wantCode: codes.InvalidArgument},
+		{name: "milestone above the latest solid milestone", milestoneIndex: 4, wantCode: codes.InvalidArgument},
+	}
+
+	g := NewGRPCServer(newTestDatabaseServer(t))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &ledgerDiffStream{sendErr: tt.sendErr}
+			err := g.GetLedgerDiff(&legacyapi.GetLedgerDiffRequest{MilestoneIndex: tt.milestoneIndex}, stream)
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantCode != codes.OK:
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("got error %v, want code %s", err, tt.wantCode)
+				}
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if len(stream.diffs) != tt.wantDiffs {
+				t.Fatalf("got %d diffs, want %d", len(stream.diffs), tt.wantDiffs)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/health"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+)
+
+const (
+	// healthCheckTimeout is the maximum duration of all health checks of a request.
+	healthCheckTimeout = 5 * time.Second
+)
+
+// isHealthy checks whether all liveness and readiness checks succeed.
+func (s *DatabaseServer) isHealthy(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	return s.HealthChecker.IsHealthy(ctx)
+}
+
+func (s *DatabaseServer) health(c echo.Context, runChecks func(ctx context.Context) ([]*health.Result, bool)) *healthResponse {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), healthCheckTimeout)
+	defer cancel()
+
+	checks, healthy := runChecks(ctx)
+
+	return &healthResponse{
+		Healthy: healthy,
+		Checks:  checks,
+	}
+}
+
+func (s *DatabaseServer) healthLive(c echo.Context) *healthResponse {
+	return s.health(c, s.HealthChecker.Live)
+}
+
+func (s *DatabaseServer) healthReady(c echo.Context) *healthResponse {
+	return s.health(c, s.HealthChecker.Ready)
+}
+
+// healthJSONResponse sends the health response with a status code the probes of the orchestrator understand.
+func healthJSONResponse(c echo.Context, resp *healthResponse) error {
+	if !resp.Healthy {
+		return httpserver.JSONResponse(c, http.StatusServiceUnavailable, resp)
+	}
+
+	return httpserver.JSONResponse(c, http.StatusOK, resp)
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+)
+
+const (
+	// JSONRPCVersion is the only supported version of the JSON-RPC protocol.
+	JSONRPCVersion = "2.0"
+
+	// JSONRPCErrorCodeParseError is returned if the body of the request is no valid JSON.
+	JSONRPCErrorCodeParseError = -32700
+	// JSONRPCErrorCodeInvalidRequest is returned if the request is no valid JSON-RPC request.
+	JSONRPCErrorCodeInvalidRequest = -32600
+	// JSONRPCErrorCodeMethodNotFound is returned if the method is not implemented.
+	JSONRPCErrorCodeMethodNotFound = -32601
+	// JSONRPCErrorCodeInvalidParams is returned if the parameters of the method are invalid.
+	JSONRPCErrorCodeInvalidParams = -32602
+	// JSONRPCErrorCodeInternalError is returned if the method failed.
+	JSONRPCErrorCodeInternalError = -32603
+	// JSONRPCErrorCodeNotFound is returned if the requested object doesn't exist.
+	JSONRPCErrorCodeNotFound = -32001
+)
+
+// newJSONRPCErrorResponse returns the response of a failed JSON-RPC request.
+func newJSONRPCErrorResponse(id json.RawMessage, code int, message string) *JSONRPCResponse {
+	return &JSONRPCResponse{
+		JSONRPC: JSONRPCVersion,
+		Error: &JSONRPCError{
+			Code:    code,
+			Message: message,
+		},
+		ID: id,
+	}
+}
+
+// jsonRPCErrorCode maps the errors of the RPC endpoints to JSON-RPC error codes.
+func jsonRPCErrorCode(err error) int {
+	if errors.Is(err, httpserver.ErrInvalidParameter) {
+		return JSONRPCErrorCodeInvalidParams
+	}
+
+	var e *echo.HTTPError
+	if errors.As(err, &e) && e.Code == http.StatusNotFound {
+		return JSONRPCErrorCodeNotFound
+	}
+
+	return JSONRPCErrorCodeInternalError
+}
+
+// jsonRPCRequestCost returns the amount of objects the JSON-RPC request asks for.
+func jsonRPCRequestCost(rawRequest json.RawMessage) int {
+	request := &JSONRPCRequest{}
+	if err := json.Unmarshal(rawRequest, request); err != nil || len(request.Params) == 0 {
+		return 1
+	}
+
+	return rpcCommandCost(request.Params)
+}
+
+// jsonRPC executes a single or a batch JSON-RPC request.
+// It returns nil if the request only contained notifications, which are not answered.
+func (s *DatabaseServer) jsonRPC(c echo.Context) (interface{}, error) {
+	var bodyBytes []byte
+	if c.Request().Body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(c.Request().Body)
+		if err != nil {
+			return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+		}
+	}
+
+	// we need to restore the body after reading it
+	restoreBody(c, bodyBytes)
+
+	if !json.Valid(bodyBytes) {
+		return newJSONRPCErrorResponse(nil, JSONRPCErrorCodeParseError, "parse error"), nil
+	}
+
+	if !isBatchRequest(bodyBytes) {
+		response := s.jsonRPCCall(c, bodyBytes)
+		if response == nil {
+			return nil, nil
+		}
+
+		return response, nil
+	}
+
+	rawRequests := []json.RawMessage{}
+	if err := json.Unmarshal(bodyBytes, &rawRequests); err != nil {
+		return newJSONRPCErrorResponse(nil, JSONRPCErrorCodeParseError, "parse error"), nil
+	}
+
+	if len(rawRequests) == 0 {
+		return newJSONRPCErrorResponse(nil, JSONRPCErrorCodeInvalidRequest, "invalid request: empty batch"), nil
+	}
+
+	if len(rawRequests) > s.RPCBatchMaxSize {
+		return newJSONRPCErrorResponse(nil, JSONRPCErrorCodeInvalidRequest, fmt.Sprintf("invalid request: too many requests. maximum allowed: %d", s.RPCBatchMaxSize)), nil
+	}
+
+	// the requested objects of all requests count against the maximum results of the API like in batch RPC requests
+	cost := 0
+	for _, rawRequest := range rawRequests {
+		if method, err := rpcCommandOfRequest(c, rawRequest); err == nil && !isBatchableRPCCommand(method) {
+			return newJSONRPCErrorResponse(nil, JSONRPCErrorCodeInvalidRequest, fmt.Sprintf("invalid request: method is not allowed in batches: %s", method)), nil
+		}
+		cost += jsonRPCRequestCost(rawRequest)
+	}
+
+	if cost > s.RestAPILimitsMaxResults {
+		return newJSONRPCErrorResponse(nil, JSONRPCErrorCodeInvalidRequest, fmt.Sprintf("invalid request: too many requested objects in batch: %d, maximum allowed: %d", cost, s.RestAPILimitsMaxResults)), nil
+	}
+
+	responses := make([]*JSONRPCResponse, len(rawRequests))
+	s.runBatch(len(rawRequests), func(index int) {
+		responses[index] = s.jsonRPCCall(c, rawRequests[index])
+	})
+
+	// notifications are not answered
+	answered := make([]*JSONRPCResponse, 0, len(responses))
+	for _, response := range responses {
+		if response != nil {
+			answered = append(answered, response)
+		}
+	}
+
+	if len(answered) == 0 {
+		return nil, nil
+	}
+
+	return answered, nil
+}
+
+// jsonRPCCall maps a single JSON-RPC request onto the RPC endpoints and executes it.
+// It returns nil if the request is a notification.
+func (s *DatabaseServer) jsonRPCCall(c echo.Context, rawRequest json.RawMessage) *JSONRPCResponse {
+	request := &JSONRPCRequest{}
+	if err := json.Unmarshal(rawRequest, request); err != nil {
+		return newJSONRPCErrorResponse(nil, JSONRPCErrorCodeInvalidRequest, "invalid request: "+err.Error())
+	}
+
+	if request.JSONRPC != JSONRPCVersion || request.Method == "" {
+		return newJSONRPCErrorResponse(request.ID, JSONRPCErrorCodeInvalidRequest, "invalid request: jsonrpc must be \""+JSONRPCVersion+"\" and method must be given")
+	}
+
+	// requests without an id are notifications, which are executed but not answered
+	isNotification := request.ID == nil
+
+	response := s.jsonRPCExecute(c, request)
+	if isNotification {
+		return nil
+	}
+
+	return response
+}
+
+// jsonRPCExecute executes the RPC endpoint of the method with the named parameters of the request.
+func (s *DatabaseServer) jsonRPCExecute(c echo.Context, request *JSONRPCRequest) *JSONRPCResponse {
+	if _, exists := s.RPCEndpoints[strings.ToLower(request.Method)]; !exists {
+		return newJSONRPCErrorResponse(request.ID, JSONRPCErrorCodeMethodNotFound, "method not found: "+request.Method)
+	}
+
+	// the named parameters become the fields of an IOTA legacy API request
+	params := make(map[string]json.RawMessage)
+	if len(request.Params) > 0 && !bytes.Equal(request.Params, []byte("null")) {
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return newJSONRPCErrorResponse(request.ID, JSONRPCErrorCodeInvalidParams, "invalid params: params must be an object")
+		}
+	}
+
+	command, err := json.Marshal(request.Method)
+	if err != nil {
+		return newJSONRPCErrorResponse(request.ID, JSONRPCErrorCodeInternalError, err.Error())
+	}
+	params["command"] = command
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return newJSONRPCErrorResponse(request.ID, JSONRPCErrorCodeInternalError, err.Error())
+	}
+
+	result, err := executeRPCCommand(newRPCCommandContext(c, body), s.RPCEndpoints, request.Method)
+	if err != nil {
+		return newJSONRPCErrorResponse(request.ID, jsonRPCErrorCode(err), err.Error())
+	}
+
+	return &JSONRPCResponse{
+		JSONRPC: JSONRPCVersion,
+		Result:  result,
+		ID:      request.ID,
+	}
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/app"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/consts"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/health"
+)
+
+func TestJSONRPCErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "invalid parameter", err: errors.WithMessage(httpserver.ErrInvalidParameter, "invalid hash"), want: JSONRPCErrorCodeInvalidParams},
+		{name: "not found", err: errors.WithMessage(echo.ErrNotFound, "transaction not found"), want: JSONRPCErrorCodeNotFound},
+		{name: "internal server error", err: errors.WithMessage(echo.ErrInternalServerError, "failed"), want: JSONRPCErrorCodeInternalError},
+		{name: "service unavailable", err: echo.ErrServiceUnavailable, want: JSONRPCErrorCodeInternalError},
+		{name: "unknown error", err: errors.New("failed"), want: JSONRPCErrorCodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jsonRPCErrorCode(tt.err); got != tt.want {
+				t.Fatalf("got error code %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONRPCEndpoint(t *testing.T) {
+	unknownTxHash := strings.Repeat("A", consts.HashTrytesSize)
+
+	tests := []struct {
+		name          string
+		body          string
+		wantStatus    int
+		wantErrorCode int
+	}{
+		{
+			name:       "result",
+			body:       `{"jsonrpc":"2.0","id":1,"method":"getNodeInfo"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "notification",
+			body:       `{"jsonrpc":"2.0","method":"getNodeInfo"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "batch of notifications",
+			body:       `[{"jsonrpc":"2.0","method":"getNodeInfo"},{"jsonrpc":"2.0","method":"unknownMethod"}]`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:          "parse error",
+			body:          `{"jsonrpc":`,
+			wantStatus:    http.StatusOK,
+			wantErrorCode: JSONRPCErrorCodeParseError,
+		},
+		{
+			name:          "invalid request",
+			body:          `{"jsonrpc":"1.0","id":1,"method":"getNodeInfo"}`,
+			wantStatus:    http.StatusOK,
+			wantErrorCode: JSONRPCErrorCodeInvalidRequest,
+		},
+		{
+			name:          "method not found",
+			body:          `{"jsonrpc":"2.0","id":1,"method":"unknownMethod"}`,
+			wantStatus:    http.StatusOK,
+			wantErrorCode: JSONRPCErrorCodeMethodNotFound,
+		},
+		{
+			name:          "invalid params",
+			body:          `{"jsonrpc":"2.0","id":1,"method":"getTrytes","params":{"hashes":["A"]}}`,
+			wantStatus:    http.StatusOK,
+			wantErrorCode: JSONRPCErrorCodeInvalidParams,
+		},
+		{
+			name:          "params are no object",
+			body:          `{"jsonrpc":"2.0","id":1,"method":"getTrytes","params":["A"]}`,
+			wantStatus:    http.StatusOK,
+			wantErrorCode: JSONRPCErrorCodeInvalidParams,
+		},
+		{
+			name:          "not found",
+			body:          fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"searchConfirmedApprover","params":{"txHash":"%s"}}`, unknownTxHash),
+			wantStatus:    http.StatusOK,
+			wantErrorCode: JSONRPCErrorCodeNotFound,
+		},
+	}
+
+	s := newTestDatabaseServer(t)
+	s.AppInfo = &app.Info{Name: "inx-api-core-v0", Version: "1.0.0"}
+	s.HealthChecker = health.New()
+	s.RPCBatchMaxSize = 10
+	s.RPCBatchMaxConcurrent = 2
+	s.RPCEndpoints = make(map[string]rpcEndpoint)
+
+	e := echo.New()
+	s.configureRoutes(CreateEchoSwagger(e, s.AppInfo.Version, false).Group("root", APIRoute))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, RouteJSONRPCEndpoint, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			if tt.wantStatus == http.StatusNoContent {
+				if rec.Body.Len() != 0 {
+					t.Fatalf("got body %s, want none", rec.Body.String())
+				}
+
+				return
+			}
+
+			response := &JSONRPCResponse{}
+			if err := json.Unmarshal(rec.Body.Bytes(), response); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantErrorCode == 0 {
+				if response.Error != nil || response.Result == nil {
+					t.Fatalf("got error %+v, want a result", response.Error)
+				}
+
+				return
+			}
+
+			if response.Error == nil || response.Error.Code != tt.wantErrorCode {
+				t.Fatalf("got error %+v, want error code %d", response.Error, tt.wantErrorCode)
+			}
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package server
 
 import (
+	"bufio"
+	"context"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
@@ -24,10 +28,29 @@ type newTxWithValueFunc[T Container] func(txHash trinary.Hash, address trinary.H
 type newTxHashWithValueFunc[H Container] func(txHash trinary.Hash, tailTxHash trinary.Hash, bundleHash trinary.Hash, address trinary.Hash, value int64) H
 type newBundleWithValueFunc[B Container, T Container] func(bundleHash trinary.Hash, tailTxHash trinary.Hash, transactions []T, lastIndex uint64) B
 
+// checkLedgerDiffIndex checks that the ledger diff of the given milestone is available,
+// which is the case for the milestones above the pruning index up to the latest solid milestone.
+func (s *DatabaseServer) checkLedgerDiffIndex(msIndex milestone.Index) error {
+	smi := s.Database.GetSolidMilestoneIndex()
+	if msIndex > smi {
+		return errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", msIndex, smi)
+	}
+
+	pruningIndex := s.Database.GetPruningIndex()
+	if msIndex <= pruningIndex {
+		return errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, pruning index is %d", msIndex, pruningIndex)
+	}
+
+	return nil
+}
+
 //nolint:nonamedreturns
-func getMilestoneStateDiff[T Container, H Container, B Container](db *database.Database, milestoneIndex milestone.Index, newTxWithValue newTxWithValueFunc[T], newTxHashWithValue newTxHashWithValueFunc[H], newBundleWithValue newBundleWithValueFunc[B, T]) (confirmedTxWithValue []H, confirmedBundlesWithValue []B, totalLedgerChanges map[string]int64, err error) {
+func getMilestoneStateDiff[T Container, H Container, B Container](ctx context.Context, db *database.Database, milestoneIndex milestone.Index, newTxWithValue newTxWithValueFunc[T], newTxHashWithValue newTxHashWithValueFunc[H], newBundleWithValue newBundleWithValueFunc[B, T]) (confirmedTxWithValue []H, confirmedBundlesWithValue []B, totalLedgerChanges map[string]int64, err error) {
+	ctx, done := db.TrackMethod(ctx, "getMilestoneStateDiff", database.AttributeMilestoneIndex.Int64(int64(milestoneIndex)))
+	keysIterated := 0
+	defer func() { done(keysIterated, database.AttributeResultCount.Int(len(confirmedBundlesWithValue))) }()
 
-	msBndl := db.GetMilestoneBundleOrNil(milestoneIndex)
+	msBndl := db.GetMilestoneBundleOrNilContext(ctx, milestoneIndex)
 	if msBndl == nil {
 		return nil, nil, nil, fmt.Errorf("milestone not found: %d", milestoneIndex)
 	}
@@ -55,7 +78,8 @@ func getMilestoneStateDiff[T Container, H Container, B Container](db *database.D
 				continue
 			}
 
-			txMeta := db.GetTxMetadataOrNil(hornet.Hash(txHash))
+			txMeta := db.GetTxMetadataOrNilContext(ctx, hornet.Hash(txHash))
+			keysIterated++
 			if txMeta == nil {
 				return nil, nil, nil, fmt.Errorf("getMilestoneStateDiff: transaction not found: %v", hornet.Hash(txHash).Trytes())
 			}
@@ -78,7 +102,7 @@ func getMilestoneStateDiff[T Container, H Container, B Container](db *database.D
 				continue
 			}
 
-			bndl := db.GetBundleOrNil(hornet.Hash(txHash))
+			bndl := db.GetBundleOrNilContext(ctx, hornet.Hash(txHash))
 			if bndl == nil {
 				txBundle := txMeta.GetBundleHash()
 
@@ -97,6 +121,7 @@ func getMilestoneStateDiff[T Container, H Container, B Container](db *database.D
 				var txsWithValue []T
 
 				txs := bndl.GetTransactions()
+				keysIterated += len(txs)
 				for _, hornetTx := range txs {
 					// hornetTx is being retained during the loop, so safe to use the pointer here
 					if hornetTx.Tx.Value != 0 {
@@ -137,7 +162,7 @@ func (s *DatabaseServer) rpcGetLedgerState(c echo.Context) (interface{}, error)
 
 	balancesTrytes := make(map[trinary.Trytes]uint64)
 	for address, balance := range balances {
-		balancesTrytes[hornet.Hash(address).Trytes()] = balance
+		balancesTrytes[addressWithChecksum(hornet.Hash(address).Trytes(), request.Checksum)] = balance
 	}
 
 	return &GetLedgerStateResponse{
@@ -152,10 +177,9 @@ func (s *DatabaseServer) rpcGetLedgerDiff(c echo.Context) (interface{}, error) {
 		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
 	}
 
-	smi := s.Database.GetSolidMilestoneIndex()
 	requestedIndex := request.MilestoneIndex
-	if requestedIndex > smi {
-		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", requestedIndex, smi)
+	if err := s.checkLedgerDiffIndex(requestedIndex); err != nil {
+		return nil, err
 	}
 
 	diff, err := s.Database.GetLedgerDiffForMilestone(c.Request().Context(), requestedIndex)
@@ -165,7 +189,7 @@ func (s *DatabaseServer) rpcGetLedgerDiff(c echo.Context) (interface{}, error) {
 
 	diffTrytes := make(map[trinary.Trytes]int64)
 	for address, balance := range diff {
-		diffTrytes[hornet.Hash(address).Trytes()] = balance
+		diffTrytes[addressWithChecksum(hornet.Hash(address).Trytes(), request.Checksum)] = balance
 	}
 
 	return &GetLedgerDiffResponse{
@@ -180,16 +204,15 @@ func (s *DatabaseServer) rpcGetLedgerDiffExt(c echo.Context) (interface{}, error
 		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
 	}
 
-	smi := s.Database.GetSolidMilestoneIndex()
 	requestedIndex := request.MilestoneIndex
-	if requestedIndex > smi {
-		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", requestedIndex, smi)
+	if err := s.checkLedgerDiffIndex(requestedIndex); err != nil {
+		return nil, err
 	}
 
 	newTxWithValue := func(txHash trinary.Hash, address trinary.Hash, index uint64, value int64) *TxWithValue {
 		return &TxWithValue{
 			TxHash:  txHash,
-			Address: address,
+			Address: addressWithChecksum(address, request.Checksum),
 			Index:   index,
 			Value:   value,
 		}
@@ -200,7 +223,7 @@ func (s *DatabaseServer) rpcGetLedgerDiffExt(c echo.Context) (interface{}, error
 			TxHash:     txHash,
 			TailTxHash: tailTxHash,
 			BundleHash: bundleHash,
-			Address:    address,
+			Address:    addressWithChecksum(address, request.Checksum),
 			Value:      value,
 		}
 	}
@@ -214,14 +237,14 @@ func (s *DatabaseServer) rpcGetLedgerDiffExt(c echo.Context) (interface{}, error
 		}
 	}
 
-	confirmedTxWithValue, confirmedBundlesWithValue, ledgerChanges, err := getMilestoneStateDiff(s.Database, requestedIndex, newTxWithValue, newTxHashWithValue, newBundleWithValue)
+	confirmedTxWithValue, confirmedBundlesWithValue, ledgerChanges, err := getMilestoneStateDiff(c.Request().Context(), s.Database, requestedIndex, newTxWithValue, newTxHashWithValue, newBundleWithValue)
 	if err != nil {
 		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
 	}
 
 	ledgerChangesTrytes := make(map[trinary.Trytes]int64)
 	for address, balance := range ledgerChanges {
-		ledgerChangesTrytes[hornet.Hash(address).Trytes()] = balance
+		ledgerChangesTrytes[addressWithChecksum(hornet.Hash(address).Trytes(), request.Checksum)] = balance
 	}
 
 	result := &GetLedgerDiffExtResponse{}
@@ -234,6 +257,11 @@ func (s *DatabaseServer) rpcGetLedgerDiffExt(c echo.Context) (interface{}, error
 }
 
 func (s *DatabaseServer) ledgerState(c echo.Context, targetIndex milestone.Index) (interface{}, error) {
+	checksum, err := parseBoolQueryParam(c, QueryParameterChecksum)
+	if err != nil {
+		return nil, err
+	}
+
 	balances, index, err := s.Database.GetLedgerStateForMilestone(c.Request().Context(), targetIndex)
 	if err != nil {
 		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
@@ -241,7 +269,7 @@ func (s *DatabaseServer) ledgerState(c echo.Context, targetIndex milestone.Index
 
 	addressesWithBalances := make(map[trinary.Trytes]string)
 	for address, balance := range balances {
-		addressesWithBalances[hornet.Hash(address).Trytes()] = strconv.FormatUint(balance, 10)
+		addressesWithBalances[addressWithChecksum(hornet.Hash(address).Trytes(), checksum)] = strconv.FormatUint(balance, 10)
 	}
 
 	return &ledgerStateResponse{
@@ -270,9 +298,13 @@ func (s *DatabaseServer) ledgerDiff(c echo.Context) (interface{}, error) {
 	}
 	msIndex := milestone.Index(msIndexIotaGo)
 
-	smi := s.Database.GetSolidMilestoneIndex()
-	if msIndex > smi {
-		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", msIndex, smi)
+	checksum, err := parseBoolQueryParam(c, QueryParameterChecksum)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkLedgerDiffIndex(msIndex); err != nil {
+		return nil, err
 	}
 
 	diff, err := s.Database.GetLedgerDiffForMilestone(c.Request().Context(), msIndex)
@@ -282,7 +314,7 @@ func (s *DatabaseServer) ledgerDiff(c echo.Context) (interface{}, error) {
 
 	addressesWithDiffs := make(map[trinary.Trytes]string)
 	for address, balance := range diff {
-		addressesWithDiffs[hornet.Hash(address).Trytes()] = strconv.FormatInt(balance, 10)
+		addressesWithDiffs[addressWithChecksum(hornet.Hash(address).Trytes(), checksum)] = strconv.FormatInt(balance, 10)
 	}
 
 	return &ledgerDiffResponse{
@@ -298,15 +330,19 @@ func (s *DatabaseServer) ledgerDiffExtended(c echo.Context) (interface{}, error)
 	}
 	msIndex := milestone.Index(msIndexIotaGo)
 
-	smi := s.Database.GetSolidMilestoneIndex()
-	if msIndex > smi {
-		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", msIndex, smi)
+	checksum, err := parseBoolQueryParam(c, QueryParameterChecksum)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkLedgerDiffIndex(msIndex); err != nil {
+		return nil, err
 	}
 
 	newTxWithValue := func(txHash trinary.Hash, address trinary.Hash, index uint64, value int64) *txWithValue {
 		return &txWithValue{
 			TxHash:  txHash,
-			Address: address,
+			Address: addressWithChecksum(address, checksum),
 			Index:   uint32(index),
 			Value:   strconv.FormatInt(value, 10),
 		}
@@ -317,7 +353,7 @@ func (s *DatabaseServer) ledgerDiffExtended(c echo.Context) (interface{}, error)
 			TxHash:     txHash,
 			TailTxHash: tailTxHash,
 			Bundle:     bundleHash,
-			Address:    address,
+			Address:    addressWithChecksum(address, checksum),
 			Value:      strconv.FormatInt(value, 10),
 		}
 	}
@@ -331,14 +367,14 @@ func (s *DatabaseServer) ledgerDiffExtended(c echo.Context) (interface{}, error)
 		}
 	}
 
-	confirmedTxWithValue, confirmedBundlesWithValue, ledgerChanges, err := getMilestoneStateDiff(s.Database, msIndex, newTxWithValue, newTxHashWithValue, newBundleWithValue)
+	confirmedTxWithValue, confirmedBundlesWithValue, ledgerChanges, err := getMilestoneStateDiff(c.Request().Context(), s.Database, msIndex, newTxWithValue, newTxHashWithValue, newBundleWithValue)
 	if err != nil {
 		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
 	}
 
 	addressesWithDiffs := make(map[trinary.Trytes]string)
 	for address, balance := range ledgerChanges {
-		addressesWithDiffs[hornet.Hash(address).Trytes()] = strconv.FormatInt(balance, 10)
+		addressesWithDiffs[addressWithChecksum(hornet.Hash(address).Trytes(), checksum)] = strconv.FormatInt(balance, 10)
 	}
 
 	return ledgerDiffExtendedResponse{
@@ -348,3 +384,104 @@ func (s *DatabaseServer) ledgerDiffExtended(c echo.Context) (interface{}, error)
 		LedgerIndex:               msIndex,
 	}, nil
 }
+
+// ledgerDiffBetweenFlushInterval is the amount of addresses after which the written part of the response is flushed.
+const ledgerDiffBetweenFlushInterval = 1000
+
+func (s *DatabaseServer) ledgerDiffBetween(c echo.Context) error {
+	fromIndexIotaGo, err := httpserver.ParseMilestoneIndexParam(c, ParameterFromIndex)
+	if err != nil {
+		return err
+	}
+	fromIndex := milestone.Index(fromIndexIotaGo)
+
+	toIndexIotaGo, err := httpserver.ParseMilestoneIndexParam(c, ParameterToIndex)
+	if err != nil {
+		return err
+	}
+	toIndex := milestone.Index(toIndexIotaGo)
+
+	checksum, err := parseBoolQueryParam(c, QueryParameterChecksum)
+	if err != nil {
+		return err
+	}
+
+	if fromIndex > toIndex {
+		return errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone range: %d is bigger than %d", fromIndex, toIndex)
+	}
+
+	// the net diff of all milestones in the range is composed in memory before it is written
+	if count := int(toIndex - fromIndex); count > s.RestAPILimitsMaxLedgerDiffRange {
+		return errors.WithMessagef(httpserver.ErrInvalidParameter, "too many milestones in range: %d, maximum allowed: %d", count, s.RestAPILimitsMaxLedgerDiffRange)
+	}
+
+	smi := s.Database.GetSolidMilestoneIndex()
+	if toIndex > smi {
+		return errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", toIndex, smi)
+	}
+
+	// the ledger state at the pruning index is the oldest one the diffs can be composed from
+	pruningIndex := s.Database.GetPruningIndex()
+	if fromIndex < pruningIndex {
+		return errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, pruning index is %d", fromIndex, pruningIndex)
+	}
+
+	diff, err := s.Database.GetLedgerDiffBetweenMilestones(c.Request().Context(), fromIndex, toIndex)
+	if err != nil {
+		return errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	addresses := make([]string, 0, len(diff))
+	for address := range diff {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+	resp.WriteHeader(http.StatusOK)
+
+	flush := func(w *bufio.Writer) error {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if flusher, ok := resp.Writer.(http.Flusher); ok {
+			flusher.Flush()
+		}
+
+		return nil
+	}
+
+	// the response has the same structure as the ledger diff of a single milestone,
+	// but it is encoded address by address, so the encoded response of big ranges isn't held in memory as well
+	w := bufio.NewWriter(resp)
+	if _, err := fmt.Fprintf(w, `{"from":%d,"to":%d,"addressDiffs":{`, fromIndex, toIndex); err != nil {
+		return err
+	}
+
+	for i, address := range addresses {
+		if i > 0 {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+
+		// trytes and numbers don't need to be escaped
+		if _, err := fmt.Fprintf(w, `"%s":"%d"`, addressWithChecksum(hornet.Hash(address).Trytes(), checksum), diff[address]); err != nil {
+			return err
+		}
+
+		if (i+1)%ledgerDiffBetweenFlushInterval == 0 {
+			if err := flush(w); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, `},"ledgerIndex":%d}`, toIndex); err != nil {
+		return err
+	}
+
+	return flush(w)
+}
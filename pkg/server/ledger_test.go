@@ -0,0 +1,89 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/consts"
+)
+
+func TestLedgerDiffBetweenRejectsInvalidRanges(t *testing.T) {
+	tests := []struct {
+		name      string
+		fromIndex string
+		toIndex   string
+	}{
+		{name: "from index is bigger than to index", fromIndex: "3", toIndex: "2"},
+		{name: "range exceeds the maximum range", fromIndex: "1", toIndex: "3"},
+		{name: "from index is below the pruning index", fromIndex: "0", toIndex: "1"},
+		{name: "to index is above the latest solid milestone", fromIndex: "3", toIndex: "4"},
+		{name: "invalid index", fromIndex: "A", toIndex: "3"},
+	}
+
+	s := newTestDatabaseServer(t)
+	s.RestAPILimitsMaxLedgerDiffRange = 1
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newRouteTestContext(http.MethodGet, RouteLedgerDiffBetween, "", map[string]string{
+				ParameterFromIndex: tt.fromIndex,
+				ParameterToIndex:   tt.toIndex,
+			})
+
+			if err := s.ledgerDiffBetween(c); !errors.Is(err, httpserver.ErrInvalidParameter) {
+				t.Fatalf("got error %v, want %v", err, httpserver.ErrInvalidParameter)
+			}
+		})
+	}
+}
+
+func TestLedgerDiffBetween(t *testing.T) {
+	s := newTestDatabaseServer(t)
+	s.RestAPILimitsMaxLedgerDiffRange = 2
+
+	c := newRouteTestContext(http.MethodGet, RouteLedgerDiffBetween, "", map[string]string{
+		ParameterFromIndex: "1",
+		ParameterToIndex:   "3",
+	})
+	if err := s.ledgerDiffBetween(c); err != nil {
+		t.Fatal(err)
+	}
+
+	// the changes of both milestones are summed up per address
+	want := fmt.Sprintf(`{"from":1,"to":3,"addressDiffs":{"%s":"5","%s":"5","%s":"-10"},"ledgerIndex":3}`,
+		strings.Repeat("A", consts.HashTrytesSize),
+		strings.Repeat("B", consts.HashTrytesSize),
+		strings.Repeat("C", consts.HashTrytesSize),
+	)
+	if got := c.Response().Writer.(*httptest.ResponseRecorder).Body.String(); got != want {
+		t.Fatalf("got response %s, want %s", got, want)
+	}
+}
+
+func TestLedgerDiffBetweenWithChecksum(t *testing.T) {
+	s := newTestDatabaseServer(t)
+	s.RestAPILimitsMaxLedgerDiffRange = 2
+
+	c := newRouteTestContext(http.MethodGet, RouteLedgerDiffBetween, "", map[string]string{
+		ParameterFromIndex: "1",
+		ParameterToIndex:   "3",
+	})
+	c.Request().URL.RawQuery = QueryParameterChecksum + "=true"
+	if err := s.ledgerDiffBetween(c); err != nil {
+		t.Fatal(err)
+	}
+
+	want := fmt.Sprintf(`{"from":1,"to":3,"addressDiffs":{"%sYLFHUOJUY":"5","%sIO9LGIBVB":"5","%sX9KV9ELOW":"-10"},"ledgerIndex":3}`,
+		strings.Repeat("A", consts.HashTrytesSize),
+		strings.Repeat("B", consts.HashTrytesSize),
+		strings.Repeat("C", consts.HashTrytesSize),
+	)
+	if got := c.Response().Writer.(*httptest.ResponseRecorder).Body.String(); got != want {
+		t.Fatalf("got response %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+func newMilestoneSignatureVerification(verification *database.MilestoneSignatureVerification) *milestoneSignatureVerification {
+	return &milestoneSignatureVerification{
+		MilestoneIndex:  verification.MilestoneIndex,
+		MilestoneHash:   verification.MilestoneHash.Trytes(),
+		SecurityLevel:   verification.SecurityLevel,
+		MerkleTreeDepth: verification.MerkleTreeDepth,
+		Valid:           verification.Valid,
+		Error:           verification.Error,
+	}
+}
+
+func (s *DatabaseServer) milestoneSignatureVerification(c echo.Context) (interface{}, error) {
+	msIndexIotaGo, err := httpserver.ParseMilestoneIndexParam(c, ParameterMilestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+	msIndex := milestone.Index(msIndexIotaGo)
+
+	verification := s.Database.VerifyMilestoneSignatureOrNil(c.Request().Context(), msIndex, s.MilestoneVerification)
+	if verification == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "milestone not found: %d", msIndex)
+	}
+
+	return &milestoneSignatureVerificationResponse{
+		MilestoneIndex:     verification.MilestoneIndex,
+		MilestoneHash:      verification.MilestoneHash.Trytes(),
+		CoordinatorAddress: s.Database.LatestSyncState().CoordinatorAddress,
+		SecurityLevel:      verification.SecurityLevel,
+		MerkleTreeDepth:    verification.MerkleTreeDepth,
+		Valid:              verification.Valid,
+		Error:              verification.Error,
+		LedgerIndex:        s.Database.GetLedgerIndex(),
+	}, nil
+}
+
+func (s *DatabaseServer) milestoneSignatureVerificationsBetween(c echo.Context) (interface{}, error) {
+	fromIndexIotaGo, err := httpserver.ParseMilestoneIndexParam(c, ParameterFromIndex)
+	if err != nil {
+		return nil, err
+	}
+	fromIndex := milestone.Index(fromIndexIotaGo)
+
+	toIndexIotaGo, err := httpserver.ParseMilestoneIndexParam(c, ParameterToIndex)
+	if err != nil {
+		return nil, err
+	}
+	toIndex := milestone.Index(toIndexIotaGo)
+
+	if fromIndex > toIndex {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone range: %d is bigger than %d", fromIndex, toIndex)
+	}
+
+	if count := int(toIndex-fromIndex) + 1; count > s.RestAPILimitsMaxResults {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "too many milestones in range: %d, maximum allowed: %d", count, s.RestAPILimitsMaxResults)
+	}
+
+	verifications, err := s.Database.VerifyMilestoneSignatures(c.Request().Context(), fromIndex, toIndex, s.MilestoneVerification)
+	if err != nil {
+		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	resp := &milestoneSignatureVerificationsResponse{
+		From:               fromIndex,
+		To:                 toIndex,
+		CoordinatorAddress: s.Database.LatestSyncState().CoordinatorAddress,
+		Milestones:         make([]*milestoneSignatureVerification, 0, len(verifications)),
+		LedgerIndex:        s.Database.GetLedgerIndex(),
+	}
+
+	for _, verification := range verifications {
+		if verification.Valid {
+			resp.ValidCount++
+		} else {
+			resp.InvalidCount++
+		}
+		resp.Milestones = append(resp.Milestones, newMilestoneSignatureVerification(verification))
+	}
+
+	return resp, nil
+}
@@ -17,20 +17,20 @@ func (s *DatabaseServer) rpcGetNodeInfo(c echo.Context) (any, error) {
 		LatestSolidSubtangleMilestone:      syncState.LatestSolidSubtangleMilestone,
 		LatestSolidSubtangleMilestoneIndex: syncState.LatestSolidSubtangleMilestoneIndex,
 		IsSynced:                           true,
-		Health:                             true,
+		Health:                             s.isHealthy(c.Request().Context()),
 		MilestoneStartIndex:                syncState.MilestoneStartIndex,
 		LastSnapshottedMilestoneIndex:      syncState.LastSnapshottedMilestoneIndex,
 		Neighbors:                          0,
 		Time:                               time.Now().UnixMilli(),
 		Tips:                               0,
 		TransactionsToRequest:              0,
-		Features:                           []string{},
+		Features:                           s.features(),
 		CoordinatorAddress:                 syncState.CoordinatorAddress,
 	}, nil
 }
 
 //nolint:unparam // even if the error is never used, the structure of all routes should be the same
-func (s *DatabaseServer) info() (*infoResponse, error) {
+func (s *DatabaseServer) info(c echo.Context) (*infoResponse, error) {
 
 	syncState := s.Database.LatestSyncState()
 
@@ -42,14 +42,14 @@ func (s *DatabaseServer) info() (*infoResponse, error) {
 		LatestSolidSubtangleMilestone:      syncState.LatestSolidSubtangleMilestone,
 		LatestSolidSubtangleMilestoneIndex: syncState.LatestSolidSubtangleMilestoneIndex,
 		IsSynced:                           true,
-		Health:                             true,
+		Health:                             s.isHealthy(c.Request().Context()),
 		MilestoneStartIndex:                syncState.MilestoneStartIndex,
 		LastSnapshottedMilestoneIndex:      syncState.LastSnapshottedMilestoneIndex,
 		Neighbors:                          0,
 		Time:                               time.Now().UnixMilli(),
 		Tips:                               0,
 		TransactionsToRequest:              0,
-		Features:                           []string{},
+		Features:                           s.features(),
 		CoordinatorAddress:                 syncState.CoordinatorAddress,
 	}, nil
 }
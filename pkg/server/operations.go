@@ -0,0 +1,460 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+)
+
+const (
+	// contextKeyRPCCommand is the key of the echo context to cache the RPC command of a request.
+	contextKeyRPCCommand = "rpcCommand"
+	// contextKeyRPCBatchCommands is the key of the echo context to cache the RPC commands of a batch request.
+	contextKeyRPCBatchCommands = "rpcBatchCommands"
+)
+
+// OperationClass groups routes and RPC commands with a similar resource usage.
+type OperationClass string
+
+const (
+	// OperationClassDefault are cheap lookups of single objects.
+	OperationClassDefault OperationClass = "default"
+	// OperationClassFindTransactions are searches and tangle walks that return lists of transactions.
+	OperationClassFindTransactions OperationClass = "findTransactions"
+	// OperationClassLedgerState are calculations of the full ledger state.
+	OperationClassLedgerState OperationClass = "ledgerState"
+	// OperationClassLedgerDiff are lookups of the ledger diff of a milestone.
+	OperationClassLedgerDiff OperationClass = "ledgerDiff"
+	// OperationClassLedgerDiffExtended are walks of the past cone of a milestone.
+	OperationClassLedgerDiffExtended OperationClass = "ledgerDiffExtended"
+)
+
+// operationClassRanks orders the operation classes by their resource usage.
+var operationClassRanks = map[OperationClass]int{
+	OperationClassDefault:            0,
+	OperationClassFindTransactions:   1,
+	OperationClassLedgerDiff:         2,
+	OperationClassLedgerDiffExtended: 3,
+	OperationClassLedgerState:        4,
+}
+
+// isRPCRequest checks whether the request is sent to the RPC or the JSON-RPC endpoint.
+func isRPCRequest(c echo.Context) bool {
+	return c.Request().Method == echo.POST && (c.Path() == RouteRPCEndpoint || c.Path() == RouteJSONRPCEndpoint)
+}
+
+// rpcCommandOfRequest returns the lower case command of a single RPC request.
+// The command of JSON-RPC requests is their method.
+func rpcCommandOfRequest(c echo.Context, rawRequest []byte) (string, error) {
+	if c.Path() == RouteJSONRPCEndpoint {
+		request := &JSONRPCRequest{}
+		if err := json.Unmarshal(rawRequest, request); err != nil {
+			return "", err
+		}
+
+		return strings.ToLower(request.Method), nil
+	}
+
+	request := &Request{}
+	if err := json.Unmarshal(rawRequest, request); err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(request.Command), nil
+}
+
+// PeekRPCCommand returns the lower case command of an RPC or JSON-RPC request without consuming the body of the request.
+// It returns an empty string if the request is not an RPC request, the body can't be parsed or it is a batch request.
+func PeekRPCCommand(c echo.Context) string {
+	if !isRPCRequest(c) {
+		return ""
+	}
+
+	if command, ok := c.Get(contextKeyRPCCommand).(string); ok {
+		return command
+	}
+
+	command := ""
+	if bodyBytes, err := peekBody(c); err == nil && !isBatchRequest(bodyBytes) {
+		if requestCommand, err := rpcCommandOfRequest(c, bodyBytes); err == nil {
+			command = requestCommand
+		}
+	}
+
+	c.Set(contextKeyRPCCommand, command)
+
+	return command
+}
+
+// PeekRPCBatchCommands returns the lower case commands of a batch RPC or JSON-RPC request without consuming the body of the request.
+// The second return value is false if the request is not a batch request.
+// The commands are nil if the body of a batch request can't be parsed.
+func PeekRPCBatchCommands(c echo.Context) ([]string, bool) {
+	if !isRPCRequest(c) {
+		return nil, false
+	}
+
+	if commands, ok := c.Get(contextKeyRPCBatchCommands).([]string); ok {
+		return commands, true
+	}
+
+	bodyBytes, err := peekBody(c)
+	if err != nil || !isBatchRequest(bodyBytes) {
+		return nil, false
+	}
+
+	var commands []string
+	rawRequests := []json.RawMessage{}
+	if err := json.Unmarshal(bodyBytes, &rawRequests); err == nil {
+		// every request is parsed on its own like in the RPC endpoint,
+		// so a single invalid request doesn't hide the commands of the others
+		commands = make([]string, 0, len(rawRequests))
+		for _, rawRequest := range rawRequests {
+			command, err := rpcCommandOfRequest(c, rawRequest)
+			if err != nil {
+				commands = append(commands, "")
+
+				continue
+			}
+			commands = append(commands, command)
+		}
+	}
+
+	c.Set(contextKeyRPCBatchCommands, commands)
+
+	return commands, true
+}
+
+// isBatchRequest checks whether the body of an RPC request contains an array of commands.
+func isBatchRequest(bodyBytes []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(bodyBytes, " \t\r\n"), []byte("["))
+}
+
+// peekBody returns the body of the request without consuming it.
+func peekBody(c echo.Context) ([]byte, error) {
+	if c.Request().Body == nil {
+		return nil, nil
+	}
+
+	bodyBytes, err := io.ReadAll(c.Request().Body)
+
+	// we need to restore the body after reading it
+	restoreBody(c, bodyBytes)
+
+	return bodyBytes, err
+}
+
+// OperationClassForRequest returns the operation class of the route or RPC command of the request.
+// Batch RPC requests have the operation class of their most expensive command.
+func OperationClassForRequest(c echo.Context) OperationClass {
+	switch c.Path() {
+	case RouteRPCEndpoint, RouteJSONRPCEndpoint:
+		if commands, isBatch := PeekRPCBatchCommands(c); isBatch {
+			class := OperationClassDefault
+			for _, command := range commands {
+				if commandClass := operationClassForRPCCommand(command); operationClassRanks[commandClass] > operationClassRanks[class] {
+					class = commandClass
+				}
+			}
+
+			return class
+		}
+
+		return operationClassForRPCCommand(PeekRPCCommand(c))
+	case RouteTransactions, RouteTransactionApprovers, RouteTransactionPastCone, RouteTransactionFutureCone, RouteTransactionConfirmedApprover, RouteTransactionInclusionProof, RouteTransactionBundleValidation, RouteLedgerFundsOnSpentAddresses:
+		return OperationClassFindTransactions
+	case RouteLedgerState, RouteLedgerStateByIndex, RouteLedgerStateCommitmentByIndex, RouteLedgerStateProofByIndex, RouteLedgerTopHolders, RouteLedgerDistribution, RouteLedgerDiffBetween:
+		return OperationClassLedgerState
+	case RouteLedgerDiffByIndex:
+		return OperationClassLedgerDiff
+	case RouteLedgerDiffExtendedByIndex, RouteMilestoneWhiteFlagConfirmationByIndex, RouteMilestoneSignatureVerificationByIndex, RouteMilestoneSignatureVerificationsBetween:
+		return OperationClassLedgerDiffExtended
+	case RouteGraphQL:
+		// a single query may walk the tangle and resolve several ledger diffs
+		return OperationClassLedgerDiffExtended
+	default:
+		return OperationClassDefault
+	}
+}
+
+// OperationClassesForRequest returns the operation classes of all commands of a batch RPC request
+// or the operation class of the route or RPC command of any other request.
+func OperationClassesForRequest(c echo.Context) []OperationClass {
+	commands, isBatch := PeekRPCBatchCommands(c)
+	if !isBatch {
+		return []OperationClass{OperationClassForRequest(c)}
+	}
+
+	classes := make([]OperationClass, 0, len(commands))
+	for _, command := range commands {
+		classes = append(classes, operationClassForRPCCommand(command))
+	}
+
+	return classes
+}
+
+func operationClassForRPCCommand(command string) OperationClass {
+	switch command {
+	case "findtransactions", "searchconfirmedapprover", "getfundsonspentaddresses":
+		return OperationClassFindTransactions
+	case "getledgerstate":
+		return OperationClassLedgerState
+	case "getledgerdiff":
+		return OperationClassLedgerDiff
+	case "getledgerdiffext", "getwhiteflagconfirmation":
+		return OperationClassLedgerDiffExtended
+	default:
+		return OperationClassDefault
+	}
+}
+
+// isBatchableRPCCommand checks whether the given lower case command may be part of a batch request.
+func isBatchableRPCCommand(command string) bool {
+	return IsBatchableOperationClass(operationClassForRPCCommand(command))
+}
+
+// IsBatchableOperationClass checks whether RPC commands of the given operation class may be part of a batch request.
+// A batch only occupies a single slot of the admission control, but its commands are executed concurrently,
+// so commands that calculate the full ledger state or walk the past cone of a milestone are not allowed in batches.
+func IsBatchableOperationClass(class OperationClass) bool {
+	switch class {
+	case OperationClassLedgerState, OperationClassLedgerDiffExtended:
+		return false
+	default:
+		return true
+	}
+}
+
+// rpcCommandForGRPCMethod returns the lower case RPC command that is implemented by the given full gRPC method,
+// e.g. "getledgerstate" for "/legacyapi.LegacyAPI/GetLedgerState".
+func rpcCommandForGRPCMethod(fullMethod string) string {
+	return strings.ToLower(path.Base(fullMethod))
+}
+
+// OperationClassForGRPCMethod returns the operation class of the RPC command that is implemented by the given full gRPC method.
+func OperationClassForGRPCMethod(fullMethod string) OperationClass {
+	return operationClassForRPCCommand(rpcCommandForGRPCMethod(fullMethod))
+}
+
+// CoalescingKeyForRequest returns the key under which identical concurrent requests are coalesced.
+// The key consists of the route and the normalized parameters of the request.
+// It returns an empty string for cheap requests that are not worth coalescing.
+func CoalescingKeyForRequest(c echo.Context) string {
+	if OperationClassForRequest(c) == OperationClassDefault {
+		return ""
+	}
+
+	switch c.Path() {
+	case RouteJSONRPCEndpoint:
+		// the responses contain the ids of the requests and can't be shared
+		return ""
+	case RouteLedgerDiffBetween:
+		// responses that are written in chunks would have to be buffered in full to be shared
+		return ""
+	case RouteGraphQL:
+		// the query is part of the body, which isn't part of the key
+		return ""
+	}
+
+	if c.Path() == RouteRPCEndpoint {
+		bodyBytes, err := peekBody(c)
+		if err != nil {
+			return ""
+		}
+
+		// re-encoding the parsed body sorts the fields and removes insignificant whitespace
+		request := make(map[string]interface{})
+		decoder := json.NewDecoder(bytes.NewReader(bodyBytes))
+		decoder.UseNumber()
+		if err := decoder.Decode(&request); err != nil {
+			return ""
+		}
+		request["command"] = PeekRPCCommand(c)
+
+		normalizedBody, err := json.Marshal(request)
+		if err != nil {
+			return ""
+		}
+
+		return fmt.Sprintf("%s %s %s", c.Request().Method, c.Path(), normalizedBody)
+	}
+
+	return normalizedRequestKey(c)
+}
+
+// normalizedRequestKey returns the method, the route and the normalized path and query parameters of the request.
+func normalizedRequestKey(c echo.Context) string {
+	var key strings.Builder
+	key.WriteString(c.Request().Method)
+	key.WriteString(" ")
+	key.WriteString(c.Path())
+
+	for i, name := range c.ParamNames() {
+		key.WriteString(" ")
+		key.WriteString(name)
+		key.WriteString("=")
+		key.WriteString(strings.ToUpper(c.ParamValues()[i]))
+	}
+
+	// the encoded query parameters are sorted by key
+	if query := c.QueryParams().Encode(); query != "" {
+		key.WriteString(" ")
+		key.WriteString(query)
+	}
+
+	return key.String()
+}
+
+// CacheKeyForRequest returns the key of requests whose responses never change for the given ledger index.
+// These are lookups of milestones at or below the ledger index, of immutable transaction data
+// and of the funds on spent addresses, which are derived from the ledger state at the ledger index.
+// The balance distributions are only immutable if the milestone index is given.
+// It returns an empty string if the response of the request may change.
+func CacheKeyForRequest(c echo.Context, ledgerIndex milestone.Index) string {
+	if c.Request().Method != echo.GET {
+		return ""
+	}
+
+	switch c.Path() {
+	case RouteLedgerStateByIndex, RouteLedgerStateCommitmentByIndex, RouteLedgerStateProofByIndex, RouteLedgerDiffByIndex, RouteLedgerDiffExtendedByIndex, RouteMilestoneWhiteFlagConfirmationByIndex, RouteMilestoneSignatureVerificationByIndex:
+		msIndex, err := httpserver.ParseMilestoneIndexParam(c, ParameterMilestoneIndex)
+		if err != nil || msIndex == 0 || milestone.Index(msIndex) > ledgerIndex {
+			return ""
+		}
+	case RouteLedgerDiffBetween, RouteMilestoneSignatureVerificationsBetween:
+		toIndex, err := httpserver.ParseMilestoneIndexParam(c, ParameterToIndex)
+		if err != nil || toIndex == 0 || milestone.Index(toIndex) > ledgerIndex {
+			return ""
+		}
+	case RouteLedgerTopHolders, RouteLedgerDistribution:
+		msIndex, err := parseMilestoneIndexQueryParam(c, QueryParameterIndex)
+		if err != nil || msIndex == 0 || msIndex > ledgerIndex {
+			return ""
+		}
+	case RouteTransactionTrytes, RouteTransactionApprovees, RouteTransactionBundleValidation, RouteTransactionInclusionProof, RouteLedgerFundsOnSpentAddresses:
+	default:
+		return ""
+	}
+
+	return fmt.Sprintf("%d %s", ledgerIndex, normalizedRequestKey(c))
+}
+
+// PermissionScope groups routes and RPC commands that are protected by the same permission.
+type PermissionScope string
+
+const (
+	// PermissionScopePublic is the scope of requests that are always accessible without credentials, e.g. the health probes.
+	PermissionScopePublic PermissionScope = "public"
+	// PermissionScopeUnknown is the scope of unknown routes and RPC commands.
+	// It is never public, so new routes stay protected until they are assigned to a scope.
+	PermissionScopeUnknown PermissionScope = "unknown"
+	// PermissionScopeInfo protects the node info.
+	PermissionScopeInfo PermissionScope = "info"
+	// PermissionScopeTransactions protects transaction, bundle and tangle lookups.
+	PermissionScopeTransactions PermissionScope = "transactions"
+	// PermissionScopeAddresses protects address balances and spent states.
+	PermissionScopeAddresses PermissionScope = "addresses"
+	// PermissionScopeLedgerDiff protects the ledger diffs of milestones.
+	PermissionScopeLedgerDiff PermissionScope = "ledgerDiff"
+	// PermissionScopeLedgerDiffExtended protects the extended ledger diffs of milestones.
+	PermissionScopeLedgerDiffExtended PermissionScope = "ledgerDiffExtended"
+	// PermissionScopeLedgerState protects the full ledger state.
+	PermissionScopeLedgerState PermissionScope = "ledgerState"
+	// PermissionScopeGraphQL protects the GraphQL endpoint.
+	PermissionScopeGraphQL PermissionScope = "graphQL"
+)
+
+// PermissionScopeForRequest returns the permission scope of the route or RPC command of the request.
+func PermissionScopeForRequest(c echo.Context) PermissionScope {
+	switch c.Path() {
+	case RouteRPCEndpoint, RouteJSONRPCEndpoint:
+		return permissionScopeForRPCCommand(PeekRPCCommand(c))
+	case RouteHealthLive, RouteHealthReady:
+		// the probes of the orchestrator don't have credentials
+		return PermissionScopePublic
+	case RouteInfo, RouteCapabilities:
+		return PermissionScopeInfo
+	case RouteTransactions,
+		RouteTransactionTrytes,
+		RouteTransactionInclusionState,
+		RouteTransactionApprovers,
+		RouteTransactionApprovees,
+		RouteTransactionPastCone,
+		RouteTransactionFutureCone,
+		RouteTransactionConfirmedApprover,
+		RouteTransactionInclusionProof,
+		RouteTransactionBundleValidation,
+		RouteBundleReattachments,
+		RouteMilestoneSignatureVerificationByIndex:
+		return PermissionScopeTransactions
+	case RouteAddressBalance, RouteAddressWasSpent:
+		return PermissionScopeAddresses
+	case RouteLedgerState, RouteLedgerStateByIndex, RouteLedgerStateCommitmentByIndex, RouteLedgerStateProofByIndex, RouteLedgerFundsOnSpentAddresses, RouteLedgerTopHolders, RouteLedgerDistribution:
+		return PermissionScopeLedgerState
+	case RouteLedgerDiffBetween, RouteMilestoneSignatureVerificationsBetween:
+		// ranges of milestones are as expensive as the ledger state and must not be public by default
+		return PermissionScopeLedgerState
+	case RouteLedgerDiffByIndex:
+		return PermissionScopeLedgerDiff
+	case RouteLedgerDiffExtendedByIndex, RouteMilestoneWhiteFlagConfirmationByIndex:
+		return PermissionScopeLedgerDiffExtended
+	case RouteGraphQL:
+		return PermissionScopeGraphQL
+	default:
+		return PermissionScopeUnknown
+	}
+}
+
+// PermissionScopesForRequest returns the permission scopes of all commands of a batch RPC request
+// or the permission scope of the route or RPC command of any other request.
+func PermissionScopesForRequest(c echo.Context) []PermissionScope {
+	commands, isBatch := PeekRPCBatchCommands(c)
+	if !isBatch {
+		return []PermissionScope{PermissionScopeForRequest(c)}
+	}
+
+	scopes := make([]PermissionScope, 0, len(commands))
+	for _, command := range commands {
+		scopes = append(scopes, permissionScopeForRPCCommand(command))
+	}
+
+	return scopes
+}
+
+// IsKnownRPCCommand checks whether the given lower case command is implemented by the RPC endpoint.
+func IsKnownRPCCommand(command string) bool {
+	return permissionScopeForRPCCommand(command) != PermissionScopeUnknown
+}
+
+// PermissionScopeForGRPCMethod returns the permission scope of the RPC command that is implemented by the given full gRPC method.
+func PermissionScopeForGRPCMethod(fullMethod string) PermissionScope {
+	return permissionScopeForRPCCommand(rpcCommandForGRPCMethod(fullMethod))
+}
+
+func permissionScopeForRPCCommand(command string) PermissionScope {
+	switch command {
+	case "getnodeinfo", "getnodeapiconfiguration":
+		return PermissionScopeInfo
+	case "findtransactions", "gettrytes", "getinclusionstates", "checkconsistency", "searchconfirmedapprover":
+		return PermissionScopeTransactions
+	case "getbalances", "wereaddressesspentfrom":
+		return PermissionScopeAddresses
+	case "getledgerstate", "getfundsonspentaddresses":
+		return PermissionScopeLedgerState
+	case "getledgerdiff":
+		return PermissionScopeLedgerDiff
+	case "getledgerdiffext", "getwhiteflagconfirmation":
+		return PermissionScopeLedgerDiffExtended
+	default:
+		// unknown commands are rejected by the RPC endpoint
+		return PermissionScopeUnknown
+	}
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newRouteTestContext returns a context of a request to the given route with the given parameters.
+func newRouteTestContext(method string, route string, body string, params map[string]string) echo.Context {
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.SetPath(route)
+
+	names := make([]string, 0, len(params))
+	values := make([]string, 0, len(params))
+	for name, value := range params {
+		names = append(names, name)
+		values = append(values, value)
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	return c
+}
+
+func TestCoalescingKeyForRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		route   string
+		body    string
+		params  map[string]string
+		wantKey bool
+	}{
+		{
+			name:    "ledger state",
+			method:  http.MethodGet,
+			route:   RouteLedgerStateByIndex,
+			params:  map[string]string{ParameterMilestoneIndex: "10"},
+			wantKey: true,
+		},
+		{
+			name:   "cheap request",
+			method: http.MethodGet,
+			route:  RouteInfo,
+		},
+		{
+			name:   "streamed ledger diff",
+			method: http.MethodGet,
+			route:  RouteLedgerDiffBetween,
+			params: map[string]string{ParameterFromIndex: "1", ParameterToIndex: "10"},
+		},
+		{
+			name:    "rpc command",
+			method:  http.MethodPost,
+			route:   RouteRPCEndpoint,
+			body:    `{"command":"getLedgerState","targetIndex":10}`,
+			wantKey: true,
+		},
+		{
+			name:   "json-rpc request",
+			method: http.MethodPost,
+			route:  RouteJSONRPCEndpoint,
+			body:   `{"jsonrpc":"2.0","id":1,"method":"getLedgerState","params":{"targetIndex":10}}`,
+		},
+		{
+			name:   "graphql query",
+			method: http.MethodPost,
+			route:  RouteGraphQL,
+			body:   `{"query":"{latestMilestone{index}}"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := CoalescingKeyForRequest(newRouteTestContext(tt.method, tt.route, tt.body, tt.params))
+			if (key != "") != tt.wantKey {
+				t.Fatalf("got key %q, want key: %t", key, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestOperationClassForRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		route     string
+		body      string
+		params    map[string]string
+		wantClass OperationClass
+	}{
+		{
+			name:      "cheap request",
+			method:    http.MethodGet,
+			route:     RouteInfo,
+			wantClass: OperationClassDefault,
+		},
+		{
+			name:      "bundle validation",
+			method:    http.MethodGet,
+			route:     RouteTransactionBundleValidation,
+			wantClass: OperationClassFindTransactions,
+		},
+		{
+			name:      "single milestone signature verification",
+			method:    http.MethodGet,
+			route:     RouteMilestoneSignatureVerificationByIndex,
+			params:    map[string]string{ParameterMilestoneIndex: "10"},
+			wantClass: OperationClassLedgerDiffExtended,
+		},
+		{
+			name:      "graphql query",
+			method:    http.MethodPost,
+			route:     RouteGraphQL,
+			body:      `{"query":"{latestMilestone{index}}"}`,
+			wantClass: OperationClassLedgerDiffExtended,
+		},
+		{
+			name:      "rpc command",
+			method:    http.MethodPost,
+			route:     RouteRPCEndpoint,
+			body:      `{"command":"getLedgerState","targetIndex":10}`,
+			wantClass: OperationClassLedgerState,
+		},
+		{
+			name:      "rpc batch uses the most expensive command",
+			method:    http.MethodPost,
+			route:     RouteRPCEndpoint,
+			body:      `[{"command":"getNodeInfo"},{"command":"getLedgerDiff","milestoneIndex":10}]`,
+			wantClass: OperationClassLedgerDiff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if class := OperationClassForRequest(newRouteTestContext(tt.method, tt.route, tt.body, tt.params)); class != tt.wantClass {
+				t.Fatalf("got class %s, want %s", class, tt.wantClass)
+			}
+		})
+	}
+}
+
+func TestPermissionScopesForRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		route      string
+		body       string
+		wantScopes []PermissionScope
+	}{
+		{
+			name:       "health probe is public",
+			method:     http.MethodGet,
+			route:      RouteHealthLive,
+			wantScopes: []PermissionScope{PermissionScopePublic},
+		},
+		{
+			name:       "known route",
+			method:     http.MethodGet,
+			route:      RouteLedgerStateByIndex,
+			wantScopes: []PermissionScope{PermissionScopeLedgerState},
+		},
+		{
+			name:       "ledger diff range is not public",
+			method:     http.MethodGet,
+			route:      RouteLedgerDiffBetween,
+			wantScopes: []PermissionScope{PermissionScopeLedgerState},
+		},
+		{
+			name:       "milestone signature verification range is not public",
+			method:     http.MethodGet,
+			route:      RouteMilestoneSignatureVerificationsBetween,
+			wantScopes: []PermissionScope{PermissionScopeLedgerState},
+		},
+		{
+			name:       "unknown route",
+			method:     http.MethodGet,
+			route:      "/api/core/v0/unknown",
+			wantScopes: []PermissionScope{PermissionScopeUnknown},
+		},
+		{
+			name:       "known rpc command",
+			method:     http.MethodPost,
+			route:      RouteRPCEndpoint,
+			body:       `{"command":"getBalances"}`,
+			wantScopes: []PermissionScope{PermissionScopeAddresses},
+		},
+		{
+			name:       "unknown rpc command",
+			method:     http.MethodPost,
+			route:      RouteRPCEndpoint,
+			body:       `{"command":"attachToTangle"}`,
+			wantScopes: []PermissionScope{PermissionScopeUnknown},
+		},
+		{
+			name:       "batch with unknown rpc command",
+			method:     http.MethodPost,
+			route:      RouteRPCEndpoint,
+			body:       `[{"command":"getNodeInfo"},{"command":"attachToTangle"}]`,
+			wantScopes: []PermissionScope{PermissionScopeInfo, PermissionScopeUnknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scopes := PermissionScopesForRequest(newRouteTestContext(tt.method, tt.route, tt.body, nil))
+
+			if len(scopes) != len(tt.wantScopes) {
+				t.Fatalf("got scopes %v, want %v", scopes, tt.wantScopes)
+			}
+			for i := range scopes {
+				if scopes[i] != tt.wantScopes[i] {
+					t.Fatalf("got scopes %v, want %v", scopes, tt.wantScopes)
+				}
+			}
+		})
+	}
+}
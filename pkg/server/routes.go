@@ -1,8 +1,6 @@
 package server
 
 import (
-	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -14,13 +12,23 @@ import (
 const (
 	ParameterAddress         = "address"
 	ParameterTransactionHash = "txHash"
+	ParameterBundleHash      = "bundleHash"
 	ParameterMilestoneIndex  = "index"
+	ParameterFromIndex       = "from"
+	ParameterToIndex         = "to"
 
 	QueryParameterBundle     = "bundle"
 	QueryParameterAddress    = "address"
 	QueryParameterTag        = "tag"
 	QueryParameterApprovee   = "approvee"
 	QueryParameterMaxResults = "maxResults"
+	QueryParameterMaxDepth   = "maxDepth"
+	QueryParameterCursor     = "cursor"
+
+	QueryParameterSearchMilestone = "searchMilestone"
+	QueryParameterChecksum        = "checksum"
+	QueryParameterLimit           = "limit"
+	QueryParameterIndex           = "index"
 )
 
 const (
@@ -28,10 +36,31 @@ const (
 	// POST sends an IOTA legacy API request and returns the results.
 	RouteRPCEndpoint = "/"
 
+	// RouteJSONRPCEndpoint is the route for sending JSON-RPC 2.0 requests to the API.
+	// POST maps the method and the named params of a single or a batch request onto the RPC commands
+	// and returns the results or the errors with JSON-RPC error codes.
+	RouteJSONRPCEndpoint = "/jsonrpc"
+
+	// RouteGraphQL is the route for sending GraphQL queries to the API.
+	// POST executes the GraphQL query and returns the results.
+	RouteGraphQL = "/graphql"
+
+	// RouteHealthLive is the route for the liveness probe.
+	// GET returns 200 if the databases are readable, 503 otherwise.
+	RouteHealthLive = "/health/live"
+
+	// RouteHealthReady is the route for the readiness probe.
+	// GET returns 200 if the node is able to serve requests, 503 otherwise.
+	RouteHealthReady = "/health/ready"
+
 	// RouteInfo is the route for getting the node info.
 	// GET returns the node info.
 	RouteInfo = "/info"
 
+	// RouteCapabilities is the route for discovering the capabilities of the API.
+	// GET returns the limits, the RPC commands, the features and the queryable milestone range.
+	RouteCapabilities = "/capabilities" // former getNodeAPIConfiguration
+
 	// RouteTransactions is the route for getting transactions filtered by the given parameters.
 	// GET with query parameter returns all txHashes that fit these filter criteria.
 	// Query parameters: "bundle", "address", "tag", "approvee", "maxResults"
@@ -46,8 +75,64 @@ const (
 	// GET will return the inclusion state.
 	RouteTransactionInclusionState = "/transactions/:" + ParameterTransactionHash + "/inclusion-state" // former getInclusionStates
 
+	// RouteTransactionApprovers is the route for getting the approvers of a transaction.
+	// GET will return the hashes of all transactions that directly reference the transaction.
+	// Query parameters: "maxResults"
+	RouteTransactionApprovers = "/transactions/:" + ParameterTransactionHash + "/approvers"
+
+	// RouteTransactionApprovees is the route for getting the approvees of a transaction.
+	// GET will return the trunk and branch transaction hashes of the transaction.
+	RouteTransactionApprovees = "/transactions/:" + ParameterTransactionHash + "/approvees"
+
+	// RouteTransactionPastCone is the route for walking the past cone of a transaction.
+	// GET will return all transactions that are directly or indirectly referenced by the transaction.
+	// The walk stops at solid entry points.
+	// Query parameters: "maxDepth", "maxResults"
+	RouteTransactionPastCone = "/transactions/:" + ParameterTransactionHash + "/past-cone"
+
+	// RouteTransactionFutureCone is the route for walking the future cone of a transaction.
+	// GET will return all transactions that directly or indirectly reference the transaction.
+	// Query parameters: "maxDepth", "maxResults"
+	RouteTransactionFutureCone = "/transactions/:" + ParameterTransactionHash + "/future-cone"
+
+	// RouteTransactionConfirmedApprover is the route for searching the nearest confirmed approver of a transaction.
+	// GET will return the confirmed approver, the milestone that confirmed it and the path of approvers to it.
+	// Every search visits at most "restAPI.limits.maxResults" transactions.
+	// Query parameters: "searchMilestone"
+	RouteTransactionConfirmedApprover = "/transactions/:" + ParameterTransactionHash + "/confirmed-approver" // former searchConfirmedApprover
+
+	// RouteTransactionInclusionProof is the route for proving that a transaction was confirmed by a milestone.
+	// GET will return the trytes of a chain of approvers from the transaction to the tail of the confirming milestone,
+	// so the proof can be verified offline by re-hashing every link and checking its trunk or branch.
+	// The last link is proven by the Merkle signature of the coordinator in the returned milestone bundle,
+	// which is also verified by RouteMilestoneSignatureVerificationByIndex.
+	RouteTransactionInclusionProof = "/transactions/:" + ParameterTransactionHash + "/inclusion-proof"
+
+	// RouteTransactionBundleValidation is the route for re-validating the bundle of a tail transaction.
+	// GET will recompute the transaction hashes, the bundle hash, the values and the signatures
+	// from the raw transactions of the bundle and return every failed check.
+	RouteTransactionBundleValidation = "/transactions/:" + ParameterTransactionHash + "/bundle-validation"
+
+	// RouteBundleReattachments is the route for getting all attachments of a bundle.
+	// GET will return the tail transactions of all attachments with their inclusion states
+	// and the attachment that got confirmed.
+	RouteBundleReattachments = "/bundles/:" + ParameterBundleHash + "/reattachments"
+
+	// RouteMilestoneWhiteFlagConfirmationByIndex is the route for getting the white-flag confirmation of a milestone.
+	// GET will return the milestone bundle and the bundles that mutated the ledger in the order in which they were applied.
+	RouteMilestoneWhiteFlagConfirmationByIndex = "/milestones/by-index/:" + ParameterMilestoneIndex + "/white-flag-confirmation" // former getWhiteFlagConfirmation
+
+	// RouteMilestoneSignatureVerificationByIndex is the route for re-verifying the signature of a milestone.
+	// GET will verify the Merkle signature of the milestone bundle against the coordinator address and return whether it is valid.
+	RouteMilestoneSignatureVerificationByIndex = "/milestones/by-index/:" + ParameterMilestoneIndex + "/signature-verification"
+
+	// RouteMilestoneSignatureVerificationsBetween is the route for re-verifying the signatures of a range of milestones.
+	// GET will verify the signatures of all stored milestones in the range and return the results ordered by index.
+	RouteMilestoneSignatureVerificationsBetween = "/milestones/signature-verification/between/:" + ParameterFromIndex + "/:" + ParameterToIndex
+
 	// RouteAddressBalance is the route for getting the balance of an address.
 	// GET will return the balance.
+	// Query parameters: "checksum"
 	RouteAddressBalance = "/addresses/:" + ParameterAddress + "/balance" // former getBalances
 
 	// RouteAddressBalance is the route to check whether an address was already spent or not.
@@ -56,18 +141,54 @@ const (
 
 	// RouteLedgerState is the route to return the current ledger state.
 	// GET will return all addresses with their balances.
+	// Query parameters: "checksum"
 	RouteLedgerState = "/ledger/state" // former getLedgerState
 
 	// RouteLedgerStateByIndex is the route to return the ledger state of a given ledger index.
 	// GET will return all addresses with their balances.
+	// Query parameters: "checksum"
 	RouteLedgerStateByIndex = "/ledger/state/by-index/:" + ParameterMilestoneIndex // former getLedgerState
 
+	// RouteLedgerStateCommitmentByIndex is the route to return the commitment over the ledger state of a given ledger index.
+	// GET will return the Merkle root over all addresses with a balance, ordered by address.
+	// A leaf is the BLAKE2b-256 hash of 0x00, the 81 address trytes and the balance as 8 byte big-endian integer,
+	// an inner node is the BLAKE2b-256 hash of 0x01 and its children (RFC 6962).
+	RouteLedgerStateCommitmentByIndex = "/ledger/state/by-index/:" + ParameterMilestoneIndex + "/commitment"
+
+	// RouteLedgerStateProofByIndex is the route to return the inclusion proof of an address in the commitment over the ledger state.
+	// GET will return the balance of the address and the path from its leaf to the Merkle root.
+	RouteLedgerStateProofByIndex = "/ledger/state/by-index/:" + ParameterMilestoneIndex + "/proof/:" + ParameterAddress
+
+	// RouteLedgerFundsOnSpentAddresses is the route to return the spent addresses that still hold funds.
+	// GET will return the spent addresses with their balances ordered by address and the total balance.
+	// It returns 503 if the node that created the database didn't track the spent addresses.
+	// Query parameters: "cursor", "maxResults"
+	RouteLedgerFundsOnSpentAddresses = "/ledger/funds-on-spent-addresses" // former getFundsOnSpentAddresses
+
+	// RouteLedgerDiffBetween is the route to return the net balance changes between the ledger states of two milestones.
+	// GET will return all addresses with their net diffs, ordered by address.
+	// The net diffs are composed in memory, so the range may span at most "restAPI.limits.maxLedgerDiffRange" milestones.
+	// Query parameters: "checksum"
+	RouteLedgerDiffBetween = "/ledger/diff/between/:" + ParameterFromIndex + "/:" + ParameterToIndex
+
+	// RouteLedgerTopHolders is the route to return the addresses with the highest balances.
+	// GET will return the addresses ordered by balance descending.
+	// Query parameters: "limit", "index", "checksum"
+	RouteLedgerTopHolders = "/ledger/top-holders"
+
+	// RouteLedgerDistribution is the route to return the distribution of the balances.
+	// GET will return a log-scale histogram of the balances and the Gini coefficient.
+	// Query parameters: "index"
+	RouteLedgerDistribution = "/ledger/distribution"
+
 	// RouteLedgerDiffByIndex is the route to return the ledger diff of a given ledger index.
 	// GET will return all addresses with their diffs.
+	// Query parameters: "checksum"
 	RouteLedgerDiffByIndex = "/ledger/diff/by-index/:" + ParameterMilestoneIndex // former getLedgerDiff
 
 	// RouteLedgerDiffExtendedByIndex is the route to return the ledger diff of a given ledger index with extended informations.
 	// GET will return all addresses with their diffs, the confirmed transactions and the confirmed bundles.
+	// Query parameters: "checksum"
 	RouteLedgerDiffExtendedByIndex = "/ledger/diff-extended/by-index/:" + ParameterMilestoneIndex // former getLedgerDiffExt
 )
 
@@ -76,32 +197,57 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 	s.configureRPCEndpoints()
 
 	routeGroup.POST(RouteRPCEndpoint, func(c echo.Context) error {
-		resp, err := rpc(c, s.RPCEndpoints)
-		if err != nil {
-			// the RPC endpoint has custom error handling for compatibility reasons
-			var e *echo.HTTPError
+		var resp interface{}
+		var err error
+		if _, isBatch := PeekRPCBatchCommands(c); isBatch {
+			resp, err = s.rpcBatch(c)
+		} else {
+			resp, err = rpc(c, s.RPCEndpoints)
+		}
 
-			var statusCode int
-			var message string
-			if errors.As(err, &e) {
-				statusCode = e.Code
-				message = fmt.Sprintf("%s, error: %s", e.Message, err)
-			} else {
-				statusCode = http.StatusInternalServerError
-				message = fmt.Sprintf("internal server error. error: %s", err)
-			}
+		if err != nil {
+			statusCode, errorReturn := rpcErrorReturn(err)
 
-			return httpserver.JSONResponse(c, statusCode, &ErrorReturn{Error: message})
+			return httpserver.JSONResponse(c, statusCode, errorReturn)
 		}
 
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	}).
-		SetDescription("the route for sending RPC requests to the API").
+		SetDescription("the route for sending RPC requests to the API. A JSON array of requests is executed as a batch and returns an array of responses.").
 		SetOperationId("rpc").
 		AddParamBody(Request{}, "", "the command of the request", true)
 
+	routeGroup.POST(RouteJSONRPCEndpoint, func(c echo.Context) error {
+		resp, err := s.jsonRPC(c)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			// the request only contained notifications
+			return c.NoContent(http.StatusNoContent)
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for sending JSON-RPC 2.0 requests to the API. A JSON array of requests is executed as a batch and returns an array of responses.").
+		SetOperationId("jsonrpc").
+		AddParamBody(JSONRPCRequest{}, "", "the JSON-RPC request", true)
+
+	routeGroup.GET(RouteHealthLive, func(c echo.Context) error {
+		return healthJSONResponse(c, s.healthLive(c))
+	}).
+		SetDescription("the route for the liveness probe. Returns 503 if the databases are not readable.").
+		SetOperationId("healthLive")
+
+	routeGroup.GET(RouteHealthReady, func(c echo.Context) error {
+		return healthJSONResponse(c, s.healthReady(c))
+	}).
+		SetDescription("the route for the readiness probe. Returns 503 if the node is not able to serve requests.").
+		SetOperationId("healthReady")
+
 	routeGroup.GET(RouteInfo, func(c echo.Context) error {
-		resp, err := s.info()
+		resp, err := s.info(c)
 		if err != nil {
 			return err
 		}
@@ -111,6 +257,17 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		SetDescription("the route for getting the node info").
 		SetOperationId("info")
 
+	routeGroup.GET(RouteCapabilities, func(c echo.Context) error {
+		resp, err := s.capabilities()
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for discovering the limits, the RPC commands and the features of the API").
+		SetOperationId("capabilities")
+
 	routeGroup.GET(RouteTransactions, func(c echo.Context) error {
 		resp, err := s.transactions(c)
 		if err != nil {
@@ -151,6 +308,146 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		SetOperationId("transactionInclusionState").
 		AddParamPath("", ParameterTransactionHash, "the hash of the transaction")
 
+	routeGroup.GET(RouteTransactionApprovers, func(c echo.Context) error {
+		resp, err := s.transactionApprovers(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for getting the approvers of a transaction").
+		SetOperationId("transactionApprovers").
+		AddParamPath("", ParameterTransactionHash, "the hash of the transaction").
+		AddParamQuery("", QueryParameterMaxResults, "limit the maximum number of results", false)
+
+	routeGroup.GET(RouteTransactionApprovees, func(c echo.Context) error {
+		resp, err := s.transactionApprovees(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for getting the approvees of a transaction").
+		SetOperationId("transactionApprovees").
+		AddParamPath("", ParameterTransactionHash, "the hash of the transaction")
+
+	routeGroup.GET(RouteTransactionPastCone, func(c echo.Context) error {
+		resp, err := s.transactionPastCone(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for walking the past cone of a transaction. The walk stops at solid entry points.").
+		SetOperationId("transactionPastCone").
+		AddParamPath("", ParameterTransactionHash, "the hash of the transaction").
+		AddParamQuery("", QueryParameterMaxDepth, "limit the maximum depth of the walk", false).
+		AddParamQuery("", QueryParameterMaxResults, "limit the maximum number of results", false)
+
+	routeGroup.GET(RouteTransactionFutureCone, func(c echo.Context) error {
+		resp, err := s.transactionFutureCone(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for walking the future cone of a transaction").
+		SetOperationId("transactionFutureCone").
+		AddParamPath("", ParameterTransactionHash, "the hash of the transaction").
+		AddParamQuery("", QueryParameterMaxDepth, "limit the maximum depth of the walk", false).
+		AddParamQuery("", QueryParameterMaxResults, "limit the maximum number of results", false)
+
+	routeGroup.GET(RouteTransactionConfirmedApprover, func(c echo.Context) error {
+		resp, err := s.transactionConfirmedApprover(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for searching the nearest confirmed approver of a transaction").
+		SetOperationId("transactionConfirmedApprover").
+		AddParamPath("", ParameterTransactionHash, "the hash of the transaction").
+		AddParamQuery("", QueryParameterSearchMilestone, "extend the path to the milestone that confirmed the approver", false)
+
+	routeGroup.GET(RouteTransactionInclusionProof, func(c echo.Context) error {
+		resp, err := s.transactionInclusionProof(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for proving that a transaction was confirmed by a milestone with a chain of approvers").
+		SetOperationId("transactionInclusionProof").
+		AddParamPath("", ParameterTransactionHash, "the hash of the transaction")
+
+	routeGroup.GET(RouteTransactionBundleValidation, func(c echo.Context) error {
+		resp, err := s.transactionBundleValidation(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for re-validating the bundle of a tail transaction from its raw transactions").
+		SetOperationId("transactionBundleValidation").
+		AddParamPath("", ParameterTransactionHash, "the hash of the tail transaction")
+
+	routeGroup.GET(RouteMilestoneWhiteFlagConfirmationByIndex, func(c echo.Context) error {
+		resp, err := s.milestoneWhiteFlagConfirmation(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for getting the bundles confirmed by a milestone in white-flag order").
+		SetOperationId("milestoneWhiteFlagConfirmation").
+		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone")
+
+	routeGroup.GET(RouteMilestoneSignatureVerificationByIndex, func(c echo.Context) error {
+		resp, err := s.milestoneSignatureVerification(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for re-verifying the signature of a milestone against the coordinator address").
+		SetOperationId("milestoneSignatureVerification").
+		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone")
+
+	routeGroup.GET(RouteMilestoneSignatureVerificationsBetween, func(c echo.Context) error {
+		resp, err := s.milestoneSignatureVerificationsBetween(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for re-verifying the signatures of a range of milestones against the coordinator address").
+		SetOperationId("milestoneSignatureVerificationsBetween").
+		AddParamPath("", ParameterFromIndex, "the index of the first milestone").
+		AddParamPath("", ParameterToIndex, "the index of the last milestone")
+
+	routeGroup.GET(RouteBundleReattachments, func(c echo.Context) error {
+		resp, err := s.bundleReattachments(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route for getting all attachments of a bundle and the attachment that got confirmed").
+		SetOperationId("bundleReattachments").
+		AddParamPath("", ParameterBundleHash, "the hash of the bundle").
+		AddParamQuery("", QueryParameterMaxResults, "limit the maximum number of results", false)
+
 	routeGroup.GET(RouteAddressBalance, func(c echo.Context) error {
 		resp, err := s.addressBalance(c)
 		if err != nil {
@@ -161,7 +458,8 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 	}).
 		SetDescription("the route for getting the balance of an address").
 		SetOperationId("addressBalance").
-		AddParamPath("", ParameterAddress, "the hash of the address")
+		AddParamPath("", ParameterAddress, "the hash of the address").
+		AddParamQuery("", QueryParameterChecksum, "whether to return the addresses with their checksum", false)
 
 	routeGroup.GET(RouteAddressWasSpent, func(c echo.Context) error {
 		resp, err := s.addressWasSpent(c)
@@ -184,7 +482,8 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	}).
 		SetDescription("the route to return the current ledger state").
-		SetOperationId("ledgerStateByLatestSolidIndex")
+		SetOperationId("ledgerStateByLatestSolidIndex").
+		AddParamQuery("", QueryParameterChecksum, "whether to return the addresses with their checksum", false)
 
 	routeGroup.GET(RouteLedgerStateByIndex, func(c echo.Context) error {
 		resp, err := s.ledgerStateByIndex(c)
@@ -196,8 +495,83 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 	}).
 		SetDescription("the route to return the ledger state of a given ledger index").
 		SetOperationId("ledgerStateByIndex").
+		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone").
+		AddParamQuery("", QueryParameterChecksum, "whether to return the addresses with their checksum", false)
+
+	routeGroup.GET(RouteLedgerStateCommitmentByIndex, func(c echo.Context) error {
+		resp, err := s.ledgerStateCommitmentByIndex(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route to return the Merkle root over the ledger state of a given ledger index").
+		SetOperationId("ledgerStateCommitmentByIndex").
 		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone")
 
+	routeGroup.GET(RouteLedgerStateProofByIndex, func(c echo.Context) error {
+		resp, err := s.ledgerStateProofByIndex(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route to return the Merkle inclusion proof of the balance of an address in the ledger state of a given ledger index").
+		SetOperationId("ledgerStateProofByIndex").
+		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone").
+		AddParamPath("", ParameterAddress, "the hash of the address")
+
+	routeGroup.GET(RouteLedgerFundsOnSpentAddresses, func(c echo.Context) error {
+		resp, err := s.ledgerFundsOnSpentAddresses(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route to return the spent addresses that still hold funds, ordered by address").
+		SetOperationId("ledgerFundsOnSpentAddresses").
+		AddParamQuery("", QueryParameterCursor, "the last address of the previous page", false).
+		AddParamQuery("", QueryParameterMaxResults, "limit the maximum number of results", false)
+
+	routeGroup.GET(RouteLedgerDiffBetween, func(c echo.Context) error {
+		// the response is written in chunks, because the diff of a big range may contain many addresses
+		return s.ledgerDiffBetween(c)
+	}).
+		SetDescription("the route to return the net balance changes between the ledger states of two milestones").
+		SetOperationId("ledgerDiffBetween").
+		AddParamPath("", ParameterFromIndex, "the index of the first milestone").
+		AddParamPath("", ParameterToIndex, "the index of the second milestone").
+		AddParamQuery("", QueryParameterChecksum, "whether to return the addresses with their checksum", false)
+
+	routeGroup.GET(RouteLedgerTopHolders, func(c echo.Context) error {
+		resp, err := s.ledgerTopHolders(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route to return the addresses with the highest balances").
+		SetOperationId("ledgerTopHolders").
+		AddParamQuery("", QueryParameterLimit, "limit the amount of returned addresses", false).
+		AddParamQuery("", QueryParameterIndex, "the index of the milestone (latest solid milestone if not given)", false).
+		AddParamQuery("", QueryParameterChecksum, "whether to return the addresses with their checksum", false)
+
+	routeGroup.GET(RouteLedgerDistribution, func(c echo.Context) error {
+		resp, err := s.ledgerDistribution(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}).
+		SetDescription("the route to return the distribution of the balances and the Gini coefficient").
+		SetOperationId("ledgerDistribution").
+		AddParamQuery("", QueryParameterIndex, "the index of the milestone (latest solid milestone if not given)", false)
+
 	routeGroup.GET(RouteLedgerDiffByIndex, func(c echo.Context) error {
 		resp, err := s.ledgerDiff(c)
 		if err != nil {
@@ -208,7 +582,8 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 	}).
 		SetDescription("the route to return the ledger diff of a given ledger index").
 		SetOperationId("ledgerDiff").
-		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone")
+		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone").
+		AddParamQuery("", QueryParameterChecksum, "whether to return the addresses with their checksum", false)
 
 	routeGroup.GET(RouteLedgerDiffExtendedByIndex, func(c echo.Context) error {
 		resp, err := s.ledgerDiffExtended(c)
@@ -220,5 +595,6 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 	}).
 		SetDescription("the route to return the ledger diff of a given ledger index with extended informations").
 		SetOperationId("ledgerDiffExtended").
-		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone")
+		AddParamPath("", ParameterMilestoneIndex, "the index of the milestone").
+		AddParamQuery("", QueryParameterChecksum, "whether to return the addresses with their checksum", false)
 }
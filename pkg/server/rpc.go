@@ -1,37 +1,52 @@
 package server
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 )
 
+// AttributeRPCCommand is the span attribute that contains the command of a RPC request.
+const AttributeRPCCommand = attribute.Key("rpc.command")
+
+var tracer = otel.Tracer("github.com/iotaledger/inx-api-core-v0/pkg/server")
+
 /*
 IOTA core-api-v0 endpoints:
 
 implemented by this plugin:
 - getNodeInfo
-- getBalances
+- getNodeAPIConfiguration
 - findTransactions
 - getTrytes
-- getLedgerDiff
-- getLedgerState
 - getInclusionStates
+- getBalances
 - wereAddressesSpentFrom
+- getLedgerState
+- getLedgerDiff
+- getLedgerDiffExt
+- getFundsOnSpentAddresses
+- checkConsistency
+- getWhiteFlagConfirmation
+- searchConfirmedApprover
 
 useless in "read-only" mode:
-- checkConsistency
 - getRequests
-- searchConfirmedApprover
 - searchEntryPoints
 - triggerSolidifier
-- getFundsOnSpentAddresses
-- getNodeAPIConfiguration
-- getLedgerDiffExt
 - addNeighbors
 - removeNeighbors
 - getNeighbors
@@ -43,7 +58,6 @@ useless in "read-only" mode:
 - getSpammerTips
 - broadcastTransactions
 - storeTransactions
-- getWhiteFlagConfirmation
 */
 
 type rpcEndpoint func(c echo.Context) (any, error)
@@ -53,9 +67,11 @@ func (s *DatabaseServer) configureRPCEndpoints() {
 		s.RPCEndpoints[strings.ToLower(endpointName)] = func(c echo.Context) (any, error) {
 			return implementation(c)
 		}
+		s.RPCCommands = append(s.RPCCommands, endpointName)
 	}
 
 	addEndpoint("getNodeInfo", s.rpcGetNodeInfo)
+	addEndpoint("getNodeAPIConfiguration", s.rpcGetNodeAPIConfiguration)
 	addEndpoint("findTransactions", s.rpcFindTransactions)
 	addEndpoint("getTrytes", s.rpcGetTrytes)
 	addEndpoint("getInclusionStates", s.rpcGetInclusionStates)
@@ -64,6 +80,10 @@ func (s *DatabaseServer) configureRPCEndpoints() {
 	addEndpoint("getLedgerState", s.rpcGetLedgerState)
 	addEndpoint("getLedgerDiff", s.rpcGetLedgerDiff)
 	addEndpoint("getLedgerDiffExt", s.rpcGetLedgerDiffExt)
+	addEndpoint("getFundsOnSpentAddresses", s.rpcGetFundsOnSpentAddresses)
+	addEndpoint("checkConsistency", s.rpcCheckConsistency)
+	addEndpoint("getWhiteFlagConfirmation", s.rpcGetWhiteFlagConfirmation)
+	addEndpoint("searchConfirmedApprover", s.rpcSearchConfirmedApprover)
 }
 
 func rpc(c echo.Context, implementedAPIcalls map[string]rpcEndpoint) (interface{}, error) {
@@ -90,10 +110,192 @@ func rpc(c echo.Context, implementedAPIcalls map[string]rpcEndpoint) (interface{
 	// we need to restore the body after reading it
 	restoreBody(c, bodyBytes)
 
-	implementation, exists := implementedAPIcalls[strings.ToLower(request.Command)]
+	return executeRPCCommand(c, implementedAPIcalls, request.Command)
+}
+
+// executeRPCCommand executes the implementation of the given command with the request of the context.
+func executeRPCCommand(c echo.Context, implementedAPIcalls map[string]rpcEndpoint, command string) (interface{}, error) {
+	implementation, exists := implementedAPIcalls[strings.ToLower(command)]
 	if !exists {
-		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "command is unknown: %s", request.Command)
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "command is unknown: %s", command)
+	}
+
+	// the database calls of the command are recorded as children of the command span
+	ctx, span := tracer.Start(c.Request().Context(), "RPC."+command,
+		trace.WithAttributes(AttributeRPCCommand.String(command)),
+	)
+	defer span.End()
+
+	c.SetRequest(c.Request().WithContext(ctx))
+
+	result, err := implementation(c)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return result, err
+}
+
+// rpcErrorReturn returns the status code and the response of a failed RPC command.
+func rpcErrorReturn(err error) (int, *ErrorReturn) {
+	// the RPC endpoint has custom error handling for compatibility reasons
+	var e *echo.HTTPError
+
+	var statusCode int
+	var message string
+	if errors.As(err, &e) {
+		statusCode = e.Code
+		message = fmt.Sprintf("%s, error: %s", e.Message, err)
+	} else {
+		statusCode = http.StatusInternalServerError
+		message = fmt.Sprintf("internal server error. error: %s", err)
+	}
+
+	return statusCode, &ErrorReturn{Error: message}
+}
+
+// rpcCommandCost returns the amount of objects the command requests, which is the length of all
+// lists in the request, but at least 1. Commands that return lists count with their "maxResults".
+func rpcCommandCost(rawRequest json.RawMessage) int {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(rawRequest, &fields); err != nil {
+		return 1
+	}
+
+	cost := 0
+	for name, value := range fields {
+		var list []json.RawMessage
+		if err := json.Unmarshal(value, &list); err == nil {
+			cost += len(list)
+
+			continue
+		}
+
+		if strings.EqualFold(name, "maxResults") {
+			var maxResults int
+			if err := json.Unmarshal(value, &maxResults); err == nil && maxResults > 0 {
+				cost += maxResults
+			}
+		}
+	}
+
+	if cost == 0 {
+		return 1
+	}
+
+	return cost
+}
+
+// rpcBatch executes the commands of a batch RPC request with bounded concurrency.
+// It returns the responses of the commands in the order of the request.
+// Failed commands don't abort the batch, their response contains the error instead.
+func (s *DatabaseServer) rpcBatch(c echo.Context) (interface{}, error) {
+	rawRequests := []json.RawMessage{}
+	if err := c.Bind(&rawRequests); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid batch request, error: %s", err)
+	}
+
+	if len(rawRequests) == 0 {
+		return nil, errors.WithMessage(httpserver.ErrInvalidParameter, "invalid batch request, error: no commands given")
+	}
+
+	if len(rawRequests) > s.RPCBatchMaxSize {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "too many commands. maximum allowed: %d", s.RPCBatchMaxSize)
+	}
+
+	// the requested objects of all commands count against the maximum results of the API,
+	// so batching doesn't bypass the limits of single requests
+	cost := 0
+	for _, rawRequest := range rawRequests {
+		if command, err := rpcCommandOfRequest(c, rawRequest); err == nil && !isBatchableRPCCommand(command) {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "command is not allowed in batches: %s", command)
+		}
+		cost += rpcCommandCost(rawRequest)
+	}
+
+	if cost > s.RestAPILimitsMaxResults {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "too many requested objects in batch: %d, maximum allowed: %d", cost, s.RestAPILimitsMaxResults)
+	}
+
+	responses := make([]interface{}, len(rawRequests))
+	s.runBatch(len(rawRequests), func(index int) {
+		responses[index] = s.rpcBatchCommand(c, rawRequests[index])
+	})
+
+	return responses, nil
+}
+
+// runBatch calls execute for all indexes of a batch with at most RPCBatchMaxConcurrent concurrent calls.
+func (s *DatabaseServer) runBatch(count int, execute func(index int)) {
+	indexes := make(chan int)
+	workers := s.RPCBatchMaxConcurrent
+	if workers < 1 {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				execute(index)
+			}
+		}()
+	}
+
+	for index := 0; index < count; index++ {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// rpcCommandResponseWriter is the response writer of a single command of a batch or a JSON-RPC request.
+// The commands return their results instead of writing them, so anything written is discarded.
+// Every command has its own response writer, because the commands of a batch are executed concurrently.
+type rpcCommandResponseWriter struct {
+	header http.Header
+}
+
+func (w *rpcCommandResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *rpcCommandResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *rpcCommandResponseWriter) WriteHeader(_ int) {}
+
+// newRPCCommandContext returns a copy of the context of the request with the given body and its own response.
+// It is used to execute a single command of a batch or a JSON-RPC request.
+func newRPCCommandContext(c echo.Context, body []byte) echo.Context {
+	httpRequest := c.Request().Clone(c.Request().Context())
+	httpRequest.Body = io.NopCloser(bytes.NewReader(body))
+	httpRequest.ContentLength = int64(len(body))
+	httpRequest.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	return c.Echo().NewContext(httpRequest, &rpcCommandResponseWriter{header: make(http.Header)})
+}
+
+// rpcBatchCommand executes a single command of a batch RPC request with its own copy of the request.
+func (s *DatabaseServer) rpcBatchCommand(c echo.Context, rawRequest json.RawMessage) interface{} {
+	request := &Request{}
+	if err := json.Unmarshal(rawRequest, request); err != nil {
+		_, errorReturn := rpcErrorReturn(errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err))
+
+		return errorReturn
+	}
+
+	result, err := executeRPCCommand(newRPCCommandContext(c, rawRequest), s.RPCEndpoints, request.Command)
+	if err != nil {
+		_, errorReturn := rpcErrorReturn(err)
+
+		return errorReturn
 	}
 
-	return implementation(c)
+	return result
 }
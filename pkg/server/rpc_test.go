@@ -0,0 +1,166 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+)
+
+func newRPCTestContext(route string, body string) echo.Context {
+	req := httptest.NewRequest(http.MethodPost, route, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.SetPath(route)
+
+	return c
+}
+
+func TestRPCBatchRejectsExpensiveCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{
+			name:    "ledger state",
+			body:    `[{"command":"getNodeInfo"},{"command":"getLedgerState","targetIndex":1}]`,
+			wantErr: httpserver.ErrInvalidParameter,
+		},
+		{
+			name:    "extended ledger diff",
+			body:    `[{"command":"getLedgerDiffExt","milestoneIndex":1}]`,
+			wantErr: httpserver.ErrInvalidParameter,
+		},
+		{
+			name:    "white flag confirmation",
+			body:    `[{"command":"getWhiteFlagConfirmation","milestoneIndex":1}]`,
+			wantErr: httpserver.ErrInvalidParameter,
+		},
+		{
+			name:    "too many requested objects",
+			body:    `[{"command":"getTrytes","hashes":["A","B"]},{"command":"getTrytes","hashes":["C","D"]}]`,
+			wantErr: httpserver.ErrInvalidParameter,
+		},
+	}
+
+	s := &DatabaseServer{
+		RestAPILimitsMaxResults: 3,
+		RPCBatchMaxSize:         10,
+		RPCBatchMaxConcurrent:   2,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.rpcBatch(newRPCTestContext(RouteRPCEndpoint, tt.body)); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONRPCBatchRejectsExpensiveMethods(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		wantErrorCode int
+	}{
+		{
+			name:          "ledger state",
+			body:          `[{"jsonrpc":"2.0","id":1,"method":"getLedgerState","params":{"targetIndex":1}}]`,
+			wantErrorCode: JSONRPCErrorCodeInvalidRequest,
+		},
+		{
+			name:          "extended ledger diff",
+			body:          `[{"jsonrpc":"2.0","id":1,"method":"getNodeInfo"},{"jsonrpc":"2.0","id":2,"method":"getLedgerDiffExt","params":{"milestoneIndex":1}}]`,
+			wantErrorCode: JSONRPCErrorCodeInvalidRequest,
+		},
+	}
+
+	s := &DatabaseServer{
+		RestAPILimitsMaxResults: 100,
+		RPCBatchMaxSize:         10,
+		RPCBatchMaxConcurrent:   2,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := s.jsonRPC(newRPCTestContext(RouteJSONRPCEndpoint, tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			response, ok := result.(*JSONRPCResponse)
+			if !ok || response.Error == nil {
+				t.Fatalf("got %#v, want an error response", result)
+			}
+
+			if response.Error.Code != tt.wantErrorCode {
+				t.Fatalf("got error code %d, want %d", response.Error.Code, tt.wantErrorCode)
+			}
+		})
+	}
+}
+
+func TestIsBatchableRPCCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		want    bool
+	}{
+		{command: "getnodeinfo", want: true},
+		{command: "findtransactions", want: true},
+		{command: "getledgerdiff", want: true},
+		{command: "getledgerstate", want: false},
+		{command: "getledgerdiffext", want: false},
+		{command: "getwhiteflagconfirmation", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			if got := isBatchableRPCCommand(tt.command); got != tt.want {
+				t.Fatalf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRPCBatchCommandsHaveTheirOwnResponse(t *testing.T) {
+	s := &DatabaseServer{
+		RestAPILimitsMaxResults: 100,
+		RPCBatchMaxSize:         10,
+		RPCBatchMaxConcurrent:   4,
+		RPCEndpoints: map[string]rpcEndpoint{
+			"writeheader": func(c echo.Context) (interface{}, error) {
+				c.Response().Header().Add("X-Command", "writeHeader")
+				if err := c.String(http.StatusTeapot, "written"); err != nil {
+					return nil, err
+				}
+
+				return c.Response().Header().Values("X-Command"), nil
+			},
+		},
+	}
+
+	c := newRouteTestContext(http.MethodPost, RouteRPCEndpoint, `[{"command":"writeHeader"},{"command":"writeHeader"},{"command":"writeHeader"}]`, nil)
+	result, err := s.rpcBatch(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, response := range result.([]interface{}) {
+		if values, ok := response.([]string); !ok || len(values) != 1 {
+			t.Fatalf("command %d got response %#v, want its own header", i, response)
+		}
+	}
+
+	recorder := c.Response().Writer.(*httptest.ResponseRecorder)
+	if c.Response().Committed || recorder.Body.Len() > 0 || len(c.Response().Header().Values("X-Command")) > 0 {
+		t.Fatal("commands wrote to the response of the batch")
+	}
+}
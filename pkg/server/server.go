@@ -6,6 +6,7 @@ import (
 
 	"github.com/iotaledger/hive.go/core/app"
 	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/health"
 )
 
 const (
@@ -13,21 +14,42 @@ const (
 )
 
 type DatabaseServer struct {
-	AppInfo                 *app.Info
-	Database                *database.Database
-	RestAPILimitsMaxResults int
-	RPCEndpoints            map[string]rpcEndpoint
+	AppInfo                    *app.Info
+	Database                   *database.Database
+	HealthChecker              *health.Checker
+	RestAPILimitsMaxResults    int
+	RestAPILimitsMaxBodyLength int64
+	// the maximum number of milestones whose ledger diffs are composed by a single request
+	RestAPILimitsMaxLedgerDiffRange int
+	RPCBatchMaxSize                 int
+	RPCBatchMaxConcurrent           int
+	GraphQLEnabled                  bool
+	MilestoneVerification           *database.MilestoneVerificationSettings
+	RPCEndpoints                    map[string]rpcEndpoint
+	RPCCommands                     []string
 }
 
-func NewDatabaseServer(swagger echoswagger.ApiRoot, appInfo *app.Info, db *database.Database, maxResults int) *DatabaseServer {
+func NewDatabaseServer(swagger echoswagger.ApiRoot, appInfo *app.Info, db *database.Database, healthChecker *health.Checker, maxResults int, maxBodyLength int64, maxLedgerDiffRange int, batchMaxSize int, batchMaxConcurrent int, graphQLEnabled bool, graphQLMaxDepth int, milestoneVerification *database.MilestoneVerificationSettings) *DatabaseServer {
 	s := &DatabaseServer{
-		AppInfo:                 appInfo,
-		Database:                db,
-		RestAPILimitsMaxResults: maxResults,
-		RPCEndpoints:            make(map[string]rpcEndpoint),
+		AppInfo:                         appInfo,
+		Database:                        db,
+		HealthChecker:                   healthChecker,
+		RestAPILimitsMaxResults:         maxResults,
+		RestAPILimitsMaxBodyLength:      maxBodyLength,
+		RestAPILimitsMaxLedgerDiffRange: maxLedgerDiffRange,
+		RPCBatchMaxSize:                 batchMaxSize,
+		RPCBatchMaxConcurrent:           batchMaxConcurrent,
+		GraphQLEnabled:                  graphQLEnabled,
+		MilestoneVerification:           milestoneVerification,
+		RPCEndpoints:                    make(map[string]rpcEndpoint),
 	}
 
-	s.configureRoutes(swagger.Group("root", APIRoute))
+	routeGroup := swagger.Group("root", APIRoute)
+	s.configureRoutes(routeGroup)
+
+	if graphQLEnabled {
+		s.configureGraphQLRoute(routeGroup, graphQLMaxDepth)
+	}
 
 	return s
 }
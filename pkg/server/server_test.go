@@ -0,0 +1,34 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/iota.go/consts"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/databasetest"
+)
+
+// newTestDatabaseServer returns a server on a database with the milestones 1 to 3 and a pruning index of 1.
+// The ledger diffs of the milestones 2 and 3 move funds between the addresses A, B and C.
+func newTestDatabaseServer(t *testing.T) *DatabaseServer {
+	t.Helper()
+
+	addressA := strings.Repeat("A", consts.HashTrytesSize)
+	addressB := strings.Repeat("B", consts.HashTrytesSize)
+	addressC := strings.Repeat("C", consts.HashTrytesSize)
+
+	tangle := databasetest.New(t)
+	tangle.SetSnapshot(1, false)
+	ms1 := tangle.AddMilestone(1, databasetest.NullHash, databasetest.NullHash)
+	ms2 := tangle.AddMilestone(2, ms1, ms1)
+	tangle.AddMilestone(3, ms2, ms2)
+	tangle.SetLedgerIndex(3)
+	tangle.AddLedgerDiff(2, map[string]int64{addressA: 10, addressC: -10})
+	tangle.AddLedgerDiff(3, map[string]int64{addressA: -5, addressB: 5})
+
+	return &DatabaseServer{
+		Database:                tangle.Database(),
+		RestAPILimitsMaxResults: 100,
+	}
+}
@@ -1,12 +1,19 @@
 package server
 
 import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota.go/address"
+	"github.com/iotaledger/iota.go/trinary"
 
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
 
@@ -23,12 +30,13 @@ func (s *DatabaseServer) rpcWereAddressesSpentFrom(c echo.Context) (interface{},
 	result := &WereAddressesSpentFromResponse{}
 
 	for _, addr := range request.Addresses {
-		if err := address.ValidAddress(addr); err != nil {
-			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address hash provided: %s", addr)
+		addrHash, err := parseAddress(addr)
+		if err != nil {
+			return nil, err
 		}
 
 		// State
-		result.States = append(result.States, s.Database.WasAddressSpentFrom(hornet.HashFromAddressTrytes(addr)))
+		result.States = append(result.States, s.Database.WasAddressSpentFromContext(c.Request().Context(), addrHash))
 	}
 
 	return result, nil
@@ -42,7 +50,128 @@ func (s *DatabaseServer) addressWasSpent(c echo.Context) (interface{}, error) {
 
 	return &addressWasSpentResponse{
 		Address:     addr.Trytes(),
-		WasSpent:    s.Database.WasAddressSpentFrom(addr),
+		WasSpent:    s.Database.WasAddressSpentFromContext(c.Request().Context(), addr),
 		LedgerIndex: s.Database.GetLedgerIndex(),
 	}, nil
 }
+
+// fundsOnSpentAddressesPage returns the funds on spent addresses that follow the cursor address
+// and the cursor of the next page. The cursor of the next page is nil if there are no further results.
+func fundsOnSpentAddressesPage(funds []*database.AddressBalance, cursor hornet.Hash, maxResults int) ([]*database.AddressBalance, hornet.Hash) {
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(funds), func(i int) bool {
+			return bytes.Compare(funds[i].Address, cursor) > 0
+		})
+	}
+
+	end := start + maxResults
+	if end >= len(funds) {
+		return funds[start:], nil
+	}
+
+	return funds[start:end], funds[end-1].Address
+}
+
+// fundsOnSpentAddresses returns all spent addresses with a non-zero balance at the ledger index and the sum of their balances.
+func (s *DatabaseServer) fundsOnSpentAddresses(c echo.Context) ([]*database.AddressBalance, uint64, error) {
+	funds, total, err := s.Database.GetFundsOnSpentAddresses(c.Request().Context())
+	if err != nil {
+		if errors.Is(err, database.ErrSpentAddressesDisabled) {
+			return nil, 0, errors.WithMessage(echo.ErrServiceUnavailable, err.Error())
+		}
+
+		return nil, 0, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	return funds, total, nil
+}
+
+func (s *DatabaseServer) rpcGetFundsOnSpentAddresses(c echo.Context) (interface{}, error) {
+	request := &GetFundsOnSpentAddresses{}
+	if err := c.Bind(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	maxResults := s.RestAPILimitsMaxResults
+	if (request.MaxResults > 0) && (request.MaxResults < maxResults) {
+		maxResults = request.MaxResults
+	}
+
+	var cursor hornet.Hash
+	if request.Cursor != "" {
+		cursorTrytes := strings.ToUpper(request.Cursor)
+		if err := address.ValidAddress(cursorTrytes); err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid cursor provided: %s", request.Cursor)
+		}
+		cursor = hornet.HashFromAddressTrytes(cursorTrytes)
+	}
+
+	funds, total, err := s.fundsOnSpentAddresses(c)
+	if err != nil {
+		return nil, err
+	}
+
+	page, nextCursor := fundsOnSpentAddressesPage(funds, cursor, maxResults)
+
+	addresses := make([]*AddressWithBalance, 0, len(page))
+	for _, fund := range page {
+		addresses = append(addresses, &AddressWithBalance{
+			Address: fund.Address.Trytes(),
+			Balance: fund.Balance,
+		})
+	}
+
+	var nextCursorTrytes trinary.Hash
+	if nextCursor != nil {
+		nextCursorTrytes = nextCursor.Trytes()
+	}
+
+	return &GetFundsOnSpentAddressesResponse{
+		Addresses:      addresses,
+		TotalBalance:   total,
+		TotalCount:     len(funds),
+		Cursor:         nextCursorTrytes,
+		MilestoneIndex: s.Database.GetLedgerIndex(),
+	}, nil
+}
+
+func (s *DatabaseServer) ledgerFundsOnSpentAddresses(c echo.Context) (interface{}, error) {
+	cursor, err := parseCursorQueryParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := parseMaxResultsQueryParam(c, s.RestAPILimitsMaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	funds, total, err := s.fundsOnSpentAddresses(c)
+	if err != nil {
+		return nil, err
+	}
+
+	page, nextCursor := fundsOnSpentAddressesPage(funds, cursor, maxResults)
+
+	addresses := make([]*addressWithBalance, 0, len(page))
+	for _, fund := range page {
+		addresses = append(addresses, &addressWithBalance{
+			Address: fund.Address.Trytes(),
+			Balance: strconv.FormatUint(fund.Balance, 10),
+		})
+	}
+
+	var nextCursorTrytes trinary.Hash
+	if nextCursor != nil {
+		nextCursorTrytes = nextCursor.Trytes()
+	}
+
+	return &fundsOnSpentAddressesResponse{
+		Addresses:    addresses,
+		TotalBalance: strconv.FormatUint(total, 10),
+		TotalCount:   len(funds),
+		Cursor:       nextCursorTrytes,
+		LedgerIndex:  s.Database.GetLedgerIndex(),
+	}, nil
+}
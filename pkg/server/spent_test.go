@@ -0,0 +1,71 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/iota.go/consts"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+)
+
+func TestFundsOnSpentAddressesPage(t *testing.T) {
+	funds := []*database.AddressBalance{
+		{Address: hornet.HashFromAddressTrytes(strings.Repeat("A", consts.HashTrytesSize)), Balance: 1},
+		{Address: hornet.HashFromAddressTrytes(strings.Repeat("B", consts.HashTrytesSize)), Balance: 2},
+		{Address: hornet.HashFromAddressTrytes(strings.Repeat("C", consts.HashTrytesSize)), Balance: 3},
+	}
+
+	tests := []struct {
+		name           string
+		cursor         hornet.Hash
+		maxResults     int
+		wantBalances   []uint64
+		wantNextCursor hornet.Hash
+	}{
+		{name: "first page", maxResults: 2, wantBalances: []uint64{1, 2}, wantNextCursor: funds[1].Address},
+		{name: "last page", cursor: funds[1].Address, maxResults: 2, wantBalances: []uint64{3}},
+		{name: "single page", maxResults: 3, wantBalances: []uint64{1, 2, 3}},
+		{name: "cursor after the last address", cursor: funds[2].Address, maxResults: 2, wantBalances: []uint64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, nextCursor := fundsOnSpentAddressesPage(funds, tt.cursor, tt.maxResults)
+
+			balances := make([]uint64, 0, len(page))
+			for _, fund := range page {
+				balances = append(balances, fund.Balance)
+			}
+
+			if len(balances) != len(tt.wantBalances) {
+				t.Fatalf("got balances %v, want %v", balances, tt.wantBalances)
+			}
+			for i := range balances {
+				if balances[i] != tt.wantBalances[i] {
+					t.Fatalf("got balances %v, want %v", balances, tt.wantBalances)
+				}
+			}
+
+			if string(nextCursor) != string(tt.wantNextCursor) {
+				t.Fatalf("got next cursor %v, want %v", nextCursor, tt.wantNextCursor)
+			}
+		})
+	}
+}
+
+func TestLedgerFundsOnSpentAddressesDisabled(t *testing.T) {
+	s := newTestDatabaseServer(t)
+
+	_, err := s.ledgerFundsOnSpentAddresses(newRouteTestContext(http.MethodGet, RouteLedgerFundsOnSpentAddresses, "", nil))
+
+	var e *echo.HTTPError
+	if !errors.As(err, &e) || e.Code != http.StatusServiceUnavailable {
+		t.Fatalf("got error %v, want status %d", err, http.StatusServiceUnavailable)
+	}
+}
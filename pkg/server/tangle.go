@@ -0,0 +1,502 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/guards"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+const (
+	// defaultConeMaxDepth is the depth used for cone walks if no depth was requested.
+	defaultConeMaxDepth = 10
+	// coneMaxDepth is the maximum depth a cone walk may be requested with.
+	coneMaxDepth = 1000
+
+	// inclusionProofApprovesTrunk marks a link of an inclusion proof that references the previous link with its trunk.
+	inclusionProofApprovesTrunk = "trunk"
+	// inclusionProofApprovesBranch marks a link of an inclusion proof that references the previous link with its branch.
+	inclusionProofApprovesBranch = "branch"
+)
+
+// coneNeighborsFunc returns the hashes of the transactions to walk next.
+type coneNeighborsFunc func(ctx context.Context, txMeta *database.TransactionMetadata) hornet.Hashes
+
+// walkCone walks the tangle in breadth-first order, starting at the given transaction.
+// The walk stops at solid entry points, after maxDepth levels or after maxResults transactions were collected.
+// It returns the collected transactions and whether the walk was stopped before the whole cone was traversed.
+func (s *DatabaseServer) walkCone(ctx context.Context, startTxHash hornet.Hash, maxDepth int, maxResults int, neighbors coneNeighborsFunc) ([]*coneTransaction, bool) {
+	cone := []*coneTransaction{}
+
+	visited := map[string]struct{}{string(startTxHash): {}}
+	currentLevel := hornet.Hashes{startTxHash}
+
+	for depth := 0; len(currentLevel) > 0; depth++ {
+		var nextLevel hornet.Hashes
+
+		for _, txHash := range currentLevel {
+			if len(cone) >= maxResults {
+				return cone, true
+			}
+
+			if s.Database.SolidEntryPointsContain(txHash) {
+				// do not walk beyond solid entry points
+				cone = append(cone, &coneTransaction{
+					TxHash:          txHash.Trytes(),
+					Depth:           depth,
+					SolidEntryPoint: true,
+				})
+
+				continue
+			}
+
+			txMeta := s.Database.GetTxMetadataOrNilContext(ctx, txHash)
+			if txMeta == nil {
+				// transaction is not part of the database
+				continue
+			}
+
+			cone = append(cone, &coneTransaction{
+				TxHash:       txHash.Trytes(),
+				TrunkTxHash:  txMeta.GetTrunkHash().Trytes(),
+				BranchTxHash: txMeta.GetBranchHash().Trytes(),
+				Depth:        depth,
+			})
+
+			if depth >= maxDepth {
+				continue
+			}
+
+			for _, neighborHash := range neighbors(ctx, txMeta) {
+				if _, seen := visited[string(neighborHash)]; seen {
+					continue
+				}
+				visited[string(neighborHash)] = struct{}{}
+
+				nextLevel = append(nextLevel, neighborHash)
+			}
+		}
+
+		if depth >= maxDepth {
+			// the walk was stopped if there would have been more transactions to walk
+			return cone, s.hasUnvisitedNeighbors(ctx, currentLevel, visited, neighbors)
+		}
+
+		currentLevel = nextLevel
+	}
+
+	return cone, false
+}
+
+// hasUnvisitedNeighbors checks if any of the given transactions has neighbors that were not visited yet.
+func (s *DatabaseServer) hasUnvisitedNeighbors(ctx context.Context, txHashes hornet.Hashes, visited map[string]struct{}, neighbors coneNeighborsFunc) bool {
+	for _, txHash := range txHashes {
+		if s.Database.SolidEntryPointsContain(txHash) {
+			continue
+		}
+
+		txMeta := s.Database.GetTxMetadataOrNilContext(ctx, txHash)
+		if txMeta == nil {
+			continue
+		}
+
+		for _, neighborHash := range neighbors(ctx, txMeta) {
+			if _, seen := visited[string(neighborHash)]; !seen {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *DatabaseServer) transactionApprovers(c echo.Context) (interface{}, error) {
+	txHash, err := parseTransactionHashParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := parseMaxResultsQueryParam(c, s.RestAPILimitsMaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	approverHashes := s.Database.GetApproverHashesContext(c.Request().Context(), txHash, maxResults)
+
+	approvers := make([]string, 0, len(approverHashes))
+	for _, approverHash := range approverHashes {
+		approvers = append(approvers, approverHash.Trytes())
+	}
+
+	return &transactionApproversResponse{
+		TxHash:      txHash.Trytes(),
+		Approvers:   approvers,
+		LedgerIndex: s.Database.GetLedgerIndex(),
+	}, nil
+}
+
+func (s *DatabaseServer) transactionApprovees(c echo.Context) (interface{}, error) {
+	txHash, err := parseTransactionHashParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	txMeta := s.Database.GetTxMetadataOrNilContext(c.Request().Context(), txHash)
+	if txMeta == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "transaction not found: %s", txHash.Trytes())
+	}
+
+	return &transactionApproveesResponse{
+		TxHash:       txHash.Trytes(),
+		TrunkTxHash:  txMeta.GetTrunkHash().Trytes(),
+		BranchTxHash: txMeta.GetBranchHash().Trytes(),
+		LedgerIndex:  s.Database.GetLedgerIndex(),
+	}, nil
+}
+
+func (s *DatabaseServer) transactionCone(c echo.Context, neighbors func(maxResults int) coneNeighborsFunc) (interface{}, error) {
+	txHash, err := parseTransactionHashParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDepth, err := parseMaxDepthQueryParam(c, defaultConeMaxDepth, coneMaxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := parseMaxResultsQueryParam(c, s.RestAPILimitsMaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := c.Request().Context()
+
+	if !s.Database.SolidEntryPointsContain(txHash) && s.Database.GetTxMetadataOrNilContext(ctx, txHash) == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "transaction not found: %s", txHash.Trytes())
+	}
+
+	cone, truncated := s.walkCone(ctx, txHash, maxDepth, maxResults, neighbors(maxResults))
+
+	return &transactionConeResponse{
+		TxHash:       txHash.Trytes(),
+		MaxDepth:     maxDepth,
+		Truncated:    truncated,
+		Transactions: cone,
+		LedgerIndex:  s.Database.GetLedgerIndex(),
+	}, nil
+}
+
+func (s *DatabaseServer) transactionPastCone(c echo.Context) (interface{}, error) {
+	return s.transactionCone(c, func(_ int) coneNeighborsFunc {
+		return func(_ context.Context, txMeta *database.TransactionMetadata) hornet.Hashes {
+			return hornet.Hashes{txMeta.GetTrunkHash(), txMeta.GetBranchHash()}
+		}
+	})
+}
+
+func (s *DatabaseServer) transactionFutureCone(c echo.Context) (interface{}, error) {
+	return s.transactionCone(c, func(maxResults int) coneNeighborsFunc {
+		return func(ctx context.Context, txMeta *database.TransactionMetadata) hornet.Hashes {
+			return s.Database.GetApproverHashesContext(ctx, txMeta.GetTxHash(), maxResults)
+		}
+	})
+}
+
+// confirmedApprover is the result of the search for a confirmed approver of a transaction.
+type confirmedApprover struct {
+	// confirmedTxHash is the first transaction found that confirms the searched transaction.
+	confirmedTxHash hornet.Hash
+	// milestoneIndex is the index of the milestone that confirmed the confirmed transaction.
+	milestoneIndex milestone.Index
+	// tanglePath is the path of approvers from the searched transaction to the confirmed transaction,
+	// or to the milestone if the milestone was searched as well.
+	tanglePath hornet.Hashes
+}
+
+// errSearchLimitExceeded is returned if a search visited more transactions than the maximum results allow.
+var errSearchLimitExceeded = errors.New("search limit exceeded")
+
+// searchApprovers walks the future cone of the transaction in breadth-first order until a transaction
+// matches the condition and returns the path of approvers from the start to this transaction.
+// Transactions that don't pass the filter are not walked. It returns nil if no transaction matches.
+// At most RestAPILimitsMaxResults transactions are visited, otherwise errSearchLimitExceeded is returned.
+func (s *DatabaseServer) searchApprovers(ctx context.Context, startTxHash hornet.Hash, condition func(txMeta *database.TransactionMetadata) bool, filter func(txMeta *database.TransactionMetadata) bool) (hornet.Hashes, error) {
+	// the approvee of every visited transaction, to reconstruct the path
+	approvees := map[string]hornet.Hash{string(startTxHash): nil}
+	currentLevel := hornet.Hashes{startTxHash}
+	visited := 0
+
+	for depth := 0; len(currentLevel) > 0 && depth <= coneMaxDepth; depth++ {
+		var nextLevel hornet.Hashes
+
+		for _, txHash := range currentLevel {
+			select {
+			case <-ctx.Done():
+				return nil, database.ErrOperationAborted
+			default:
+			}
+
+			if visited >= s.RestAPILimitsMaxResults {
+				return nil, errSearchLimitExceeded
+			}
+			visited++
+
+			txMeta := s.Database.GetTxMetadataOrNilContext(ctx, txHash)
+			if txMeta == nil || !filter(txMeta) {
+				continue
+			}
+
+			if condition(txMeta) {
+				// the path is collected from the end, so it is reversed afterwards
+				path := hornet.Hashes{}
+				for hash := txHash; hash != nil; hash = approvees[string(hash)] {
+					path = append(path, hash)
+				}
+
+				for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+					path[i], path[j] = path[j], path[i]
+				}
+
+				return path, nil
+			}
+
+			for _, approverHash := range s.Database.GetApproverHashesContext(ctx, txHash, s.RestAPILimitsMaxResults) {
+				if _, seen := approvees[string(approverHash)]; seen {
+					continue
+				}
+				approvees[string(approverHash)] = txHash
+
+				nextLevel = append(nextLevel, approverHash)
+			}
+		}
+
+		currentLevel = nextLevel
+	}
+
+	return nil, nil
+}
+
+// searchConfirmedApprover searches the nearest confirmed transaction in the future cone of the transaction.
+// If searchMilestone is set, the path is extended to the milestone that confirmed the transaction.
+func (s *DatabaseServer) searchConfirmedApprover(ctx context.Context, txHash hornet.Hash, searchMilestone bool) (*confirmedApprover, error) {
+	if s.Database.GetTxMetadataOrNilContext(ctx, txHash) == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "transaction not found: %s", txHash.Trytes())
+	}
+
+	tanglePath, err := s.searchApprovers(ctx, txHash,
+		func(txMeta *database.TransactionMetadata) bool {
+			return txMeta.IsConfirmed()
+		},
+		func(_ *database.TransactionMetadata) bool {
+			return true
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errSearchLimitExceeded) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "no confirmed approver found within %d transactions: %s", s.RestAPILimitsMaxResults, txHash.Trytes())
+		}
+
+		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	if tanglePath == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "no confirmed approver found: %s", txHash.Trytes())
+	}
+
+	confirmedTxHash := tanglePath[len(tanglePath)-1]
+	_, milestoneIndex := s.Database.GetTxMetadataOrNilContext(ctx, confirmedTxHash).GetConfirmed()
+
+	result := &confirmedApprover{
+		confirmedTxHash: confirmedTxHash,
+		milestoneIndex:  milestoneIndex,
+		tanglePath:      tanglePath,
+	}
+
+	if !searchMilestone {
+		return result, nil
+	}
+
+	ms := s.Database.GetMilestoneOrNilContext(ctx, milestoneIndex)
+	if ms == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "milestone not found: %d", milestoneIndex)
+	}
+
+	// the milestone references all transactions it confirmed
+	milestonePath, err := s.searchApprovers(ctx, confirmedTxHash,
+		func(txMeta *database.TransactionMetadata) bool {
+			return string(txMeta.GetTxHash()) == string(ms.Hash)
+		},
+		func(txMeta *database.TransactionMetadata) bool {
+			confirmed, at := txMeta.GetConfirmed()
+
+			return confirmed && at == milestoneIndex
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errSearchLimitExceeded) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "milestone %d not found within %d transactions of %s", milestoneIndex, s.RestAPILimitsMaxResults, confirmedTxHash.Trytes())
+		}
+
+		return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	if milestonePath == nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "milestone %d not found in the future cone of %s", milestoneIndex, confirmedTxHash.Trytes())
+	}
+
+	// the confirmed transaction is the start of the milestone path
+	result.tanglePath = append(result.tanglePath, milestonePath[1:]...)
+
+	return result, nil
+}
+
+func (s *DatabaseServer) rpcSearchConfirmedApprover(c echo.Context) (interface{}, error) {
+	request := &SearchConfirmedApprover{}
+	if err := c.Bind(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	if !guards.IsTransactionHash(request.TxHash) {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid transaction hash provided: %s", request.TxHash)
+	}
+
+	result, err := s.searchConfirmedApprover(c.Request().Context(), hornet.HashFromHashTrytes(request.TxHash), request.SearchMilestone)
+	if err != nil {
+		return nil, err
+	}
+
+	tanglePath := make([]trinary.Hash, 0, len(result.tanglePath))
+	for _, txHash := range result.tanglePath {
+		tanglePath = append(tanglePath, txHash.Trytes())
+	}
+
+	return &SearchConfirmedApproverResponse{
+		ConfirmedTxHash:           result.confirmedTxHash.Trytes(),
+		ConfirmedByMilestoneIndex: result.milestoneIndex,
+		TanglePath:                tanglePath,
+		TanglePathLength:          len(tanglePath),
+	}, nil
+}
+
+func (s *DatabaseServer) transactionConfirmedApprover(c echo.Context) (interface{}, error) {
+	txHash, err := parseTransactionHashParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	searchMilestone, err := parseBoolQueryParam(c, QueryParameterSearchMilestone)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.searchConfirmedApprover(c.Request().Context(), txHash, searchMilestone)
+	if err != nil {
+		return nil, err
+	}
+
+	tanglePath := make([]trinary.Hash, 0, len(result.tanglePath))
+	for _, hash := range result.tanglePath {
+		tanglePath = append(tanglePath, hash.Trytes())
+	}
+
+	return &transactionConfirmedApproverResponse{
+		TxHash:                    txHash.Trytes(),
+		ConfirmedTxHash:           result.confirmedTxHash.Trytes(),
+		ConfirmedByMilestoneIndex: result.milestoneIndex,
+		TanglePath:                tanglePath,
+		LedgerIndex:               s.Database.GetLedgerIndex(),
+	}, nil
+}
+
+func (s *DatabaseServer) transactionInclusionProof(c echo.Context) (interface{}, error) {
+	txHash, err := parseTransactionHashParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := c.Request().Context()
+
+	txMeta := s.Database.GetTxMetadataOrNilContext(ctx, txHash)
+	if txMeta == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "transaction not found: %s", txHash.Trytes())
+	}
+
+	// the transaction itself is the start of the path, if it is confirmed
+	if confirmed, _ := txMeta.GetConfirmed(); !confirmed {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "transaction not confirmed: %s", txHash.Trytes())
+	}
+
+	result, err := s.searchConfirmedApprover(ctx, txHash, true)
+	if err != nil {
+		return nil, err
+	}
+	milestoneIndex := result.milestoneIndex
+	path := result.tanglePath
+
+	milestoneBundle := s.Database.GetMilestoneBundleOrNilContext(ctx, milestoneIndex)
+	if milestoneBundle == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "milestone not found: %d", milestoneIndex)
+	}
+
+	// the signature fragments and the siblings of the milestone are needed to verify the last link offline
+	milestoneTxs := milestoneBundle.GetTransactions()
+	sort.Slice(milestoneTxs, func(i, j int) bool { return milestoneTxs[i].Tx.CurrentIndex < milestoneTxs[j].Tx.CurrentIndex })
+
+	milestoneTrytes := make([]trinary.Trytes, 0, len(milestoneTxs))
+	for _, tx := range milestoneTxs {
+		txTrytes, err := transaction.TransactionToTrytes(tx.Tx)
+		if err != nil {
+			return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+		}
+		milestoneTrytes = append(milestoneTrytes, txTrytes)
+	}
+
+	links := make([]*inclusionProofLink, 0, len(path))
+	for i, hash := range path {
+		tx := s.Database.GetTransactionOrNilContext(ctx, hash)
+		if tx == nil {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "transaction not found: %s", hash.Trytes())
+		}
+
+		txTrytes, err := transaction.TransactionToTrytes(tx.Tx)
+		if err != nil {
+			return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+		}
+
+		link := &inclusionProofLink{
+			TxHash: hash.Trytes(),
+			Trytes: txTrytes,
+		}
+
+		// every link references the previous link with its trunk or its branch
+		if i > 0 {
+			link.Approves = inclusionProofApprovesBranch
+			if tx.Tx.TrunkTransaction == path[i-1].Trytes() {
+				link.Approves = inclusionProofApprovesTrunk
+			}
+		}
+
+		links = append(links, link)
+	}
+
+	return &transactionInclusionProofResponse{
+		TxHash:             txHash.Trytes(),
+		MilestoneIndex:     milestoneIndex,
+		MilestoneTxHash:    path[len(path)-1].Trytes(),
+		Path:               links,
+		MilestoneBundle:    milestoneTrytes,
+		CoordinatorAddress: s.Database.LatestSyncState().CoordinatorAddress,
+		LedgerIndex:        s.Database.GetLedgerIndex(),
+	}, nil
+}
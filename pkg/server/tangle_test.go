@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/databasetest"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+)
+
+func pastConeNeighbors(_ context.Context, txMeta *database.TransactionMetadata) hornet.Hashes {
+	return hornet.Hashes{txMeta.GetTrunkHash(), txMeta.GetBranchHash()}
+}
+
+func TestWalkCone(t *testing.T) {
+	// the transactions form a chain from the null hash up to tx3
+	tangle := databasetest.New(t)
+	tx1 := tangle.AddTransaction(tangle.NewTransaction(databasetest.NullHash, databasetest.NullHash), 0)
+	tx2 := tangle.AddTransaction(tangle.NewTransaction(tx1, tx1), 0)
+	tx3 := tangle.AddTransaction(tangle.NewTransaction(tx2, tx2), 0)
+
+	// the same chain with the same hashes, but tx1 is a solid entry point
+	tangleWithEntryPoint := databasetest.New(t)
+	tangleWithEntryPoint.AddTransaction(tangleWithEntryPoint.NewTransaction(databasetest.NullHash, databasetest.NullHash), 0)
+	tangleWithEntryPoint.AddTransaction(tangleWithEntryPoint.NewTransaction(tx1, tx1), 0)
+	tangleWithEntryPoint.AddTransaction(tangleWithEntryPoint.NewTransaction(tx2, tx2), 0)
+	tangleWithEntryPoint.AddSolidEntryPoint(tx1, 1)
+
+	tests := []struct {
+		name                 string
+		tangle               *databasetest.Tangle
+		maxDepth             int
+		maxResults           int
+		wantHashes           []trinary.Hash
+		wantSolidEntryPoints []trinary.Hash
+		wantTruncated        bool
+	}{
+		{
+			name:                 "whole cone",
+			tangle:               tangle,
+			maxDepth:             10,
+			maxResults:           10,
+			wantHashes:           []trinary.Hash{tx3, tx2, tx1, databasetest.NullHash},
+			wantSolidEntryPoints: []trinary.Hash{databasetest.NullHash},
+		},
+		{
+			name:          "depth limit",
+			tangle:        tangle,
+			maxDepth:      1,
+			maxResults:    10,
+			wantHashes:    []trinary.Hash{tx3, tx2},
+			wantTruncated: true,
+		},
+		{
+			name:          "results limit",
+			tangle:        tangle,
+			maxDepth:      10,
+			maxResults:    3,
+			wantHashes:    []trinary.Hash{tx3, tx2, tx1},
+			wantTruncated: true,
+		},
+		{
+			name:                 "depth limit at the end of the cone",
+			tangle:               tangle,
+			maxDepth:             3,
+			maxResults:           10,
+			wantHashes:           []trinary.Hash{tx3, tx2, tx1, databasetest.NullHash},
+			wantSolidEntryPoints: []trinary.Hash{databasetest.NullHash},
+		},
+		{
+			name:                 "walk stops at solid entry points",
+			tangle:               tangleWithEntryPoint,
+			maxDepth:             10,
+			maxResults:           10,
+			wantHashes:           []trinary.Hash{tx3, tx2, tx1},
+			wantSolidEntryPoints: []trinary.Hash{tx1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &DatabaseServer{Database: tt.tangle.Database()}
+
+			cone, truncated := s.walkCone(context.Background(), hornet.HashFromHashTrytes(tx3), tt.maxDepth, tt.maxResults, pastConeNeighbors)
+
+			hashes := []trinary.Hash{}
+			var solidEntryPoints []trinary.Hash
+			for depth, coneTx := range cone {
+				// every level of the chain holds a single transaction
+				if coneTx.Depth != depth {
+					t.Fatalf("got depth %d for %s, want %d", coneTx.Depth, coneTx.TxHash, depth)
+				}
+
+				hashes = append(hashes, coneTx.TxHash)
+				if coneTx.SolidEntryPoint {
+					solidEntryPoints = append(solidEntryPoints, coneTx.TxHash)
+				}
+			}
+
+			if !reflect.DeepEqual(hashes, tt.wantHashes) || !reflect.DeepEqual(solidEntryPoints, tt.wantSolidEntryPoints) {
+				t.Fatalf("got cone %v with solid entry points %v, want %v with %v", hashes, solidEntryPoints, tt.wantHashes, tt.wantSolidEntryPoints)
+			}
+
+			if truncated != tt.wantTruncated {
+				t.Fatalf("got truncated %t, want %t", truncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestTransactionInclusionProof(t *testing.T) {
+	// txA is approved by the branch of txB, which is approved by the trunk of the milestone
+	tangle := databasetest.New(t)
+	txA := tangle.AddTransaction(tangle.NewTransaction(databasetest.NullHash, databasetest.NullHash), 1)
+	txB := tangle.AddTransaction(tangle.NewTransaction(databasetest.NullHash, txA), 1)
+	ms1 := tangle.AddMilestone(1, txB, databasetest.NullHash)
+	unconfirmed := tangle.AddTransaction(tangle.NewTransaction(ms1, ms1), 0)
+	tangle.SetLedgerIndex(1)
+
+	s := &DatabaseServer{
+		Database:                tangle.Database(),
+		RestAPILimitsMaxResults: 100,
+	}
+
+	inclusionProof := func(txHash trinary.Hash) (*transactionInclusionProofResponse, error) {
+		c := newRouteTestContext(http.MethodGet, RouteTransactionInclusionProof, "", map[string]string{
+			ParameterTransactionHash: txHash,
+		})
+
+		resp, err := s.transactionInclusionProof(c)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.(*transactionInclusionProofResponse), nil
+	}
+
+	t.Run("path to the milestone", func(t *testing.T) {
+		proof, err := inclusionProof(txA)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if proof.MilestoneIndex != 1 || proof.MilestoneTxHash != ms1 || len(proof.MilestoneBundle) != 1 {
+			t.Fatalf("got milestone %s at %d with %d transactions, want %s at 1 with 1 transaction", proof.MilestoneTxHash, proof.MilestoneIndex, len(proof.MilestoneBundle), ms1)
+		}
+
+		wantPath := []struct {
+			txHash   trinary.Hash
+			approves string
+		}{
+			{txHash: txA},
+			{txHash: txB, approves: inclusionProofApprovesBranch},
+			{txHash: ms1, approves: inclusionProofApprovesTrunk},
+		}
+
+		if len(proof.Path) != len(wantPath) {
+			t.Fatalf("got path of %d links, want %d", len(proof.Path), len(wantPath))
+		}
+		for i, link := range proof.Path {
+			if link.TxHash != wantPath[i].txHash || link.Approves != wantPath[i].approves {
+				t.Fatalf("got link %s approving %q at position %d, want %s approving %q", link.TxHash, link.Approves, i, wantPath[i].txHash, wantPath[i].approves)
+			}
+
+			// the trytes of every link can be hashed to verify the path offline
+			tx, err := transaction.AsTransactionObject(link.Trytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tx.Hash != link.TxHash {
+				t.Fatalf("got trytes of %s for link %s", tx.Hash, link.TxHash)
+			}
+		}
+	})
+
+	for name, txHash := range map[string]trinary.Hash{
+		"unconfirmed transaction": unconfirmed,
+		"unknown transaction":     strings.Repeat("A", consts.HashTrytesSize),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var httpErr *echo.HTTPError
+			if _, err := inclusionProof(txHash); !errors.As(err, &httpErr) || httpErr.Code != http.StatusNotFound {
+				t.Fatalf("got error %v, want %d", err, http.StatusNotFound)
+			}
+		})
+	}
+}
@@ -1,20 +1,20 @@
 package server
 
 import (
+	"context"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/inx-app/pkg/httpserver"
-	"github.com/iotaledger/iota.go/address"
 	"github.com/iotaledger/iota.go/guards"
 	"github.com/iotaledger/iota.go/trinary"
 
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
 )
 
-func (s *DatabaseServer) findTransactions(maxResults int, valueOnly bool, queryBundleHashes, queryApproveeHashes, queryAddressHashes, queryTagHashes map[string]struct{}) []string {
+func (s *DatabaseServer) findTransactions(ctx context.Context, maxResults int, valueOnly bool, queryBundleHashes, queryApproveeHashes, queryAddressHashes, queryTagHashes map[string]struct{}) []string {
 
 	results := make(map[string]struct{})
 	searchedBefore := false
@@ -23,7 +23,7 @@ func (s *DatabaseServer) findTransactions(maxResults int, valueOnly bool, queryB
 	if len(queryBundleHashes) > 0 {
 		// search txs by bundle hash
 		for bundleHash := range queryBundleHashes {
-			for _, r := range s.Database.GetBundleTransactionHashes(hornet.Hash(bundleHash), true, maxResults-len(results)) {
+			for _, r := range s.Database.GetBundleTransactionHashesContext(ctx, hornet.Hash(bundleHash), true, maxResults-len(results)) {
 				results[string(r)] = struct{}{}
 			}
 		}
@@ -35,7 +35,7 @@ func (s *DatabaseServer) findTransactions(maxResults int, valueOnly bool, queryB
 		if !searchedBefore {
 			// search txs by approvees
 			for approveeHash := range queryApproveeHashes {
-				for _, r := range s.Database.GetApproverHashes(hornet.Hash(approveeHash), maxResults-len(results)) {
+				for _, r := range s.Database.GetApproverHashesContext(ctx, hornet.Hash(approveeHash), maxResults-len(results)) {
 					results[string(r)] = struct{}{}
 				}
 			}
@@ -63,7 +63,7 @@ func (s *DatabaseServer) findTransactions(maxResults int, valueOnly bool, queryB
 		if !searchedBefore {
 			// search txs by address
 			for addressHash := range queryAddressHashes {
-				for _, r := range s.Database.GetTransactionHashesForAddress(hornet.Hash(addressHash), valueOnly, true, maxResults-len(results)) {
+				for _, r := range s.Database.GetTransactionHashesForAddressContext(ctx, hornet.Hash(addressHash), valueOnly, true, maxResults-len(results)) {
 					results[string(r)] = struct{}{}
 				}
 			}
@@ -91,7 +91,7 @@ func (s *DatabaseServer) findTransactions(maxResults int, valueOnly bool, queryB
 		if !searchedBefore {
 			// search txs by tags
 			for tagHash := range queryTagHashes {
-				for _, r := range s.Database.GetTagHashes(hornet.Hash(tagHash), true, maxResults-len(results)) {
+				for _, r := range s.Database.GetTagHashesContext(ctx, hornet.Hash(tagHash), true, maxResults-len(results)) {
 					results[string(r)] = struct{}{}
 				}
 			}
@@ -158,10 +158,11 @@ func (s *DatabaseServer) rpcFindTransactions(c echo.Context) (interface{}, error
 	}
 
 	for _, addressTrytes := range request.Addresses {
-		if err := address.ValidAddress(addressTrytes); err != nil {
-			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address hash provided: %s", addressTrytes)
+		addressHash, err := parseAddress(addressTrytes)
+		if err != nil {
+			return nil, err
 		}
-		queryAddressHashes[string(hornet.HashFromAddressTrytes(addressTrytes))] = struct{}{}
+		queryAddressHashes[string(addressHash)] = struct{}{}
 	}
 
 	for _, tagTrytes := range request.Tags {
@@ -177,7 +178,7 @@ func (s *DatabaseServer) rpcFindTransactions(c echo.Context) (interface{}, error
 		queryTagHashes[string(hornet.HashFromTagTrytes(tagTrytes))] = struct{}{}
 	}
 
-	txHashes := s.findTransactions(maxResults, request.ValueOnly, queryBundleHashes, queryApproveeHashes, queryAddressHashes, queryTagHashes)
+	txHashes := s.findTransactions(c.Request().Context(), maxResults, request.ValueOnly, queryBundleHashes, queryApproveeHashes, queryAddressHashes, queryTagHashes)
 
 	return &FindTransactionsResponse{
 		Hashes: txHashes,
@@ -238,7 +239,7 @@ func (s *DatabaseServer) transactions(c echo.Context) (interface{}, error) {
 		queryTagHashes[string(requestTagHash)] = struct{}{}
 	}
 
-	txHashes := s.findTransactions(maxResults, valueOnly, queryBundleHashes, queryApproveeHashes, queryAddressHashes, queryTagHashes)
+	txHashes := s.findTransactions(c.Request().Context(), maxResults, valueOnly, queryBundleHashes, queryApproveeHashes, queryAddressHashes, queryTagHashes)
 
 	return &transactionsResponse{
 		Bundle: func() string {
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/iotaledger/inx-api-core-v0/pkg/health"
 	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
 	"github.com/iotaledger/iota.go/trinary"
 )
@@ -25,6 +26,35 @@ type infoResponse struct {
 	CoordinatorAddress                 trinary.Hash    `json:"coordinatorAddress"`
 }
 
+// capabilitiesResponse defines the response of a GET capabilities REST API call.
+type capabilitiesResponse struct {
+	AppName    string `json:"appName"`
+	AppVersion string `json:"appVersion"`
+	// MaxResults is the maximum number of results that may be returned by an endpoint.
+	MaxResults int `json:"maxResults"`
+	// MaxBodyLength is the maximum number of bytes the body of a request may contain.
+	MaxBodyLength int64 `json:"maxBodyLength"`
+	// MaxBatchSize is the maximum number of commands in a batch RPC request.
+	MaxBatchSize int `json:"maxBatchSize"`
+	// RPCCommands are the commands implemented by the RPC endpoint.
+	RPCCommands []string `json:"rpcCommands"`
+	// Features are the optional features that are available.
+	Features []string `json:"features"`
+	// SpentAddressesEnabled tells whether the spent addresses were tracked by the node that created the database.
+	SpentAddressesEnabled bool `json:"spentAddressesEnabled"`
+	// PruningIndex is the index of the last pruned milestone. Milestones above it up to the ledger index can be queried.
+	PruningIndex milestone.Index `json:"pruningIndex"`
+	LedgerIndex  milestone.Index `json:"ledgerIndex"`
+}
+
+// healthResponse defines the response of a GET health REST API call.
+type healthResponse struct {
+	// Healthy tells whether all checks succeeded.
+	Healthy bool `json:"healthy"`
+	// Checks are the results of the single checks.
+	Checks []*health.Result `json:"checks"`
+}
+
 // transactionsResponse struct.
 type transactionsResponse struct {
 	Bundle            trinary.Hash    `json:"bundle,omitempty"`
@@ -50,6 +80,105 @@ type transactionInclusionStateResponse struct {
 	LedgerIndex milestone.Index `json:"ledgerIndex"`
 }
 
+// transactionApproversResponse struct.
+type transactionApproversResponse struct {
+	TxHash      trinary.Hash    `json:"txHash"`
+	Approvers   []trinary.Hash  `json:"approvers"`
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// transactionApproveesResponse struct.
+type transactionApproveesResponse struct {
+	TxHash       trinary.Hash    `json:"txHash"`
+	TrunkTxHash  trinary.Hash    `json:"trunkTxHash"`
+	BranchTxHash trinary.Hash    `json:"branchTxHash"`
+	LedgerIndex  milestone.Index `json:"ledgerIndex"`
+}
+
+// coneTransaction struct.
+type coneTransaction struct {
+	TxHash          trinary.Hash `json:"txHash"`
+	TrunkTxHash     trinary.Hash `json:"trunkTxHash,omitempty"`
+	BranchTxHash    trinary.Hash `json:"branchTxHash,omitempty"`
+	Depth           int          `json:"depth"`
+	SolidEntryPoint bool         `json:"solidEntryPoint"`
+}
+
+// transactionConeResponse struct.
+type transactionConeResponse struct {
+	TxHash       trinary.Hash       `json:"txHash"`
+	MaxDepth     int                `json:"maxDepth"`
+	Truncated    bool               `json:"truncated"`
+	Transactions []*coneTransaction `json:"transactions"`
+	LedgerIndex  milestone.Index    `json:"ledgerIndex"`
+}
+
+// transactionConfirmedApproverResponse struct.
+type transactionConfirmedApproverResponse struct {
+	TxHash                    trinary.Hash    `json:"txHash"`
+	ConfirmedTxHash           trinary.Hash    `json:"confirmedTxHash"`
+	ConfirmedByMilestoneIndex milestone.Index `json:"confirmedByMilestoneIndex"`
+	TanglePath                []trinary.Hash  `json:"tanglePath"`
+	LedgerIndex               milestone.Index `json:"ledgerIndex"`
+}
+
+// inclusionProofLink struct.
+type inclusionProofLink struct {
+	TxHash   trinary.Hash   `json:"txHash"`
+	Trytes   trinary.Trytes `json:"trytes"`
+	Approves string         `json:"approves,omitempty"`
+}
+
+// transactionInclusionProofResponse struct.
+type transactionInclusionProofResponse struct {
+	TxHash          trinary.Hash          `json:"txHash"`
+	MilestoneIndex  milestone.Index       `json:"milestoneIndex"`
+	MilestoneTxHash trinary.Hash          `json:"milestoneTxHash"`
+	Path            []*inclusionProofLink `json:"path"`
+	// MilestoneBundle are the trytes of the transactions of the milestone bundle, ordered by their index in the bundle.
+	MilestoneBundle    []trinary.Trytes `json:"milestoneBundle"`
+	CoordinatorAddress trinary.Hash     `json:"coordinatorAddress"`
+	LedgerIndex        milestone.Index  `json:"ledgerIndex"`
+}
+
+// bundleAttachment struct.
+type bundleAttachment struct {
+	TailTxHash        trinary.Hash    `json:"tailTxHash"`
+	Complete          bool            `json:"complete"`
+	Valid             bool            `json:"valid"`
+	Confirmed         bool            `json:"confirmed"`
+	Conflicting       bool            `json:"conflicting"`
+	ConfirmationIndex milestone.Index `json:"confirmationIndex,omitempty"`
+}
+
+// bundleReattachmentsResponse struct.
+type bundleReattachmentsResponse struct {
+	Bundle              trinary.Hash        `json:"bundle"`
+	Attachments         []*bundleAttachment `json:"attachments"`
+	ConfirmedTailTxHash trinary.Hash        `json:"confirmedTailTxHash,omitempty"`
+	ConfirmationIndex   milestone.Index     `json:"confirmationIndex,omitempty"`
+	LedgerIndex         milestone.Index     `json:"ledgerIndex"`
+}
+
+// bundleValidationFailure struct.
+type bundleValidationFailure struct {
+	Check string `json:"check"`
+	Error string `json:"error"`
+}
+
+// bundleValidationResponse struct.
+type bundleValidationResponse struct {
+	TailTxHash         trinary.Hash               `json:"tailTxHash"`
+	Bundle             trinary.Hash               `json:"bundle"`
+	ComputedBundleHash trinary.Hash               `json:"computedBundleHash,omitempty"`
+	TxHashes           []trinary.Hash             `json:"txHashes"`
+	Valid              bool                       `json:"valid"`
+	Failures           []*bundleValidationFailure `json:"failures"`
+	Stored             bool                       `json:"stored"`
+	StoredValid        bool                       `json:"storedValid"`
+	LedgerIndex        milestone.Index            `json:"ledgerIndex"`
+}
+
 // addressWasSpentResponse struct.
 type addressWasSpentResponse struct {
 	Address     trinary.Hash    `json:"address"`
@@ -70,6 +199,93 @@ type ledgerStateResponse struct {
 	LedgerIndex milestone.Index         `json:"ledgerIndex"`
 }
 
+// ledgerStateCommitmentResponse struct.
+type ledgerStateCommitmentResponse struct {
+	// Root is the hex encoded Merkle root over the ledger state.
+	Root string `json:"root"`
+	// LeafCount is the amount of addresses with a balance.
+	LeafCount   int             `json:"leafCount"`
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// merkleProofStep struct.
+type merkleProofStep struct {
+	// Hash is the hex encoded hash of the sibling.
+	Hash string `json:"hash"`
+	// IsLeft tells whether the sibling is the left child of the parent.
+	IsLeft bool `json:"isLeft"`
+}
+
+// ledgerStateProofResponse struct.
+type ledgerStateProofResponse struct {
+	Address trinary.Hash `json:"address"`
+	Balance string       `json:"balance"`
+	// LeafIndex is the index of the address in the ordered leaves.
+	LeafIndex int `json:"leafIndex"`
+	// LeafCount is the amount of addresses with a balance.
+	LeafCount int `json:"leafCount"`
+	// Path are the siblings on the path from the leaf to the root.
+	Path []*merkleProofStep `json:"path"`
+	// Root is the hex encoded Merkle root over the ledger state.
+	Root        string          `json:"root"`
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// addressWithBalance struct.
+type addressWithBalance struct {
+	Address trinary.Hash `json:"address"`
+	Balance string       `json:"balance"`
+}
+
+// fundsOnSpentAddressesResponse struct.
+type fundsOnSpentAddressesResponse struct {
+	// Addresses are the spent addresses with funds of the requested page.
+	Addresses []*addressWithBalance `json:"addresses"`
+	// TotalBalance is the sum of the balances of all spent addresses.
+	TotalBalance string `json:"totalBalance"`
+	// TotalCount is the amount of all spent addresses with funds.
+	TotalCount int `json:"totalCount"`
+	// Cursor is the cursor of the next page. It is empty if there are no further results.
+	Cursor      trinary.Hash    `json:"cursor,omitempty"`
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// topHoldersResponse struct.
+type topHoldersResponse struct {
+	// Addresses are the addresses with the highest balances, ordered by balance descending.
+	Addresses []*addressWithBalance `json:"addresses"`
+	// TotalBalance is the sum of the balances of all addresses.
+	TotalBalance string `json:"totalBalance"`
+	// TotalCount is the amount of all addresses with a balance.
+	TotalCount  int             `json:"totalCount"`
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// balanceBucket struct.
+type balanceBucket struct {
+	// MinBalance is the inclusive lower bound of the balances in the bucket.
+	MinBalance string `json:"minBalance"`
+	// MaxBalance is the exclusive upper bound of the balances in the bucket.
+	MaxBalance string `json:"maxBalance"`
+	// Addresses is the amount of addresses in the bucket.
+	Addresses int `json:"addresses"`
+	// Balance is the sum of the balances of the addresses in the bucket.
+	Balance string `json:"balance"`
+}
+
+// distributionResponse struct.
+type distributionResponse struct {
+	// Buckets are the addresses grouped by the decimal order of magnitude of their balance.
+	Buckets []*balanceBucket `json:"buckets"`
+	// TotalBalance is the sum of the balances of all addresses.
+	TotalBalance string `json:"totalBalance"`
+	// TotalCount is the amount of all addresses with a balance.
+	TotalCount int `json:"totalCount"`
+	// Gini is the Gini coefficient of the balances of all addresses.
+	Gini        float64         `json:"gini"`
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
 // ledgerDiffResponse struct.
 type ledgerDiffResponse struct {
 	AddressDiffs map[trinary.Hash]string `json:"addressDiffs"`
@@ -120,3 +336,44 @@ type ledgerDiffExtendedResponse struct {
 	AddressDiffs              map[trinary.Hash]string `json:"addressDiffs"`
 	LedgerIndex               milestone.Index         `json:"ledgerIndex"`
 }
+
+// whiteFlagConfirmationResponse struct.
+type whiteFlagConfirmationResponse struct {
+	MilestoneIndex  milestone.Index    `json:"milestoneIndex"`
+	MilestoneBundle []trinary.Trytes   `json:"milestoneBundle"`
+	IncludedBundles [][]trinary.Trytes `json:"includedBundles"`
+	LedgerIndex     milestone.Index    `json:"ledgerIndex"`
+}
+
+// milestoneSignatureVerification struct.
+type milestoneSignatureVerification struct {
+	MilestoneIndex  milestone.Index `json:"milestoneIndex"`
+	MilestoneHash   trinary.Hash    `json:"milestoneHash"`
+	SecurityLevel   int             `json:"securityLevel,omitempty"`
+	MerkleTreeDepth int             `json:"merkleTreeDepth,omitempty"`
+	Valid           bool            `json:"valid"`
+	Error           string          `json:"error,omitempty"`
+}
+
+// milestoneSignatureVerificationResponse struct.
+type milestoneSignatureVerificationResponse struct {
+	MilestoneIndex     milestone.Index `json:"milestoneIndex"`
+	MilestoneHash      trinary.Hash    `json:"milestoneHash"`
+	CoordinatorAddress trinary.Hash    `json:"coordinatorAddress"`
+	SecurityLevel      int             `json:"securityLevel,omitempty"`
+	MerkleTreeDepth    int             `json:"merkleTreeDepth,omitempty"`
+	Valid              bool            `json:"valid"`
+	Error              string          `json:"error,omitempty"`
+	LedgerIndex        milestone.Index `json:"ledgerIndex"`
+}
+
+// milestoneSignatureVerificationsResponse struct.
+type milestoneSignatureVerificationsResponse struct {
+	From               milestone.Index                   `json:"from"`
+	To                 milestone.Index                   `json:"to"`
+	CoordinatorAddress trinary.Hash                      `json:"coordinatorAddress"`
+	ValidCount         int                               `json:"validCount"`
+	InvalidCount       int                               `json:"invalidCount"`
+	Milestones         []*milestoneSignatureVerification `json:"milestones"`
+	LedgerIndex        milestone.Index                   `json:"ledgerIndex"`
+}
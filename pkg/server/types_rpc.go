@@ -1,6 +1,8 @@
 package server
 
 import (
+	"encoding/json"
+
 	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
 	"github.com/iotaledger/iota.go/trinary"
 )
@@ -15,6 +17,30 @@ type ErrorReturn struct {
 	Error string `json:"error"`
 }
 
+/////////////////////// JSON-RPC 2.0 //////////////////////////////
+
+// JSONRPCRequest struct.
+type JSONRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// JSONRPCError struct.
+type JSONRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// JSONRPCResponse struct.
+type JSONRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *JSONRPCError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
 /////////////////////// getNodeInfo ///////////////////////////////
 
 // GetNodeInfoResponse struct.
@@ -38,6 +64,20 @@ type GetNodeInfoResponse struct {
 	Duration                           int             `json:"duration"`
 }
 
+/////////////////// getNodeAPIConfiguration ///////////////////////
+
+// GetNodeAPIConfigurationResponse struct.
+type GetNodeAPIConfigurationResponse struct {
+	MaxFindTransactions int             `json:"maxFindTransactions"`
+	MaxRequestsList     int             `json:"maxRequestsList"`
+	MaxGetTrytes        int             `json:"maxGetTrytes"`
+	MaxBodyLength       int64           `json:"maxBodyLength"`
+	MilestoneStartIndex milestone.Index `json:"milestoneStartIndex"`
+	Features            []string        `json:"features"`
+	Commands            []string        `json:"commands"`
+	Duration            int             `json:"duration"`
+}
+
 /////////////////// findTransactions //////////////////////////////
 
 // FindTransactions struct.
@@ -97,6 +137,20 @@ type GetBalancesResponse struct {
 	Duration       int             `json:"duration"`
 }
 
+/////////////////// checkConsistency ////////////////////////
+
+// CheckConsistency struct.
+type CheckConsistency struct {
+	Tails []trinary.Hash `json:"tails"`
+}
+
+// CheckConsistencyResponse struct.
+type CheckConsistencyResponse struct {
+	State    bool   `json:"state"`
+	Info     string `json:"info"`
+	Duration int    `json:"duration"`
+}
+
 /////////////////// wereAddressesSpentFrom ////////////////////////
 
 // WereAddressesSpentFrom struct.
@@ -115,6 +169,7 @@ type WereAddressesSpentFromResponse struct {
 // GetLedgerState struct.
 type GetLedgerState struct {
 	TargetIndex milestone.Index `json:"targetIndex,omitempty"`
+	Checksum    bool            `json:"checksum,omitempty"`
 }
 
 // GetLedgerStateResponse struct.
@@ -124,11 +179,36 @@ type GetLedgerStateResponse struct {
 	Duration       int                     `json:"duration"`
 }
 
+/////////////////// getFundsOnSpentAddresses ////////////////////////
+
+// GetFundsOnSpentAddresses struct.
+type GetFundsOnSpentAddresses struct {
+	MaxResults int          `json:"maxResults,omitempty"`
+	Cursor     trinary.Hash `json:"cursor,omitempty"`
+}
+
+// AddressWithBalance struct.
+type AddressWithBalance struct {
+	Address trinary.Hash `json:"address"`
+	Balance uint64       `json:"balance"`
+}
+
+// GetFundsOnSpentAddressesResponse struct.
+type GetFundsOnSpentAddressesResponse struct {
+	Addresses      []*AddressWithBalance `json:"addresses"`
+	TotalBalance   uint64                `json:"totalBalance"`
+	TotalCount     int                   `json:"totalCount"`
+	Cursor         trinary.Hash          `json:"cursor,omitempty"`
+	MilestoneIndex milestone.Index       `json:"milestoneIndex"`
+	Duration       int                   `json:"duration"`
+}
+
 /////////////////// getLedgerDiff ////////////////////////
 
 // GetLedgerDiff struct.
 type GetLedgerDiff struct {
 	MilestoneIndex milestone.Index `json:"milestoneIndex"`
+	Checksum       bool            `json:"checksum,omitempty"`
 }
 
 // GetLedgerDiffResponse struct.
@@ -143,6 +223,7 @@ type GetLedgerDiffResponse struct {
 // GetLedgerDiffExt struct.
 type GetLedgerDiffExt struct {
 	MilestoneIndex milestone.Index `json:"milestoneIndex"`
+	Checksum       bool            `json:"checksum,omitempty"`
 }
 
 // TxHashWithValue struct.
@@ -190,3 +271,34 @@ type GetLedgerDiffExtResponse struct {
 	MilestoneIndex            milestone.Index        `json:"milestoneIndex"`
 	Duration                  int                    `json:"duration"`
 }
+
+/////////////////// getWhiteFlagConfirmation ////////////////////////
+
+// GetWhiteFlagConfirmation struct.
+type GetWhiteFlagConfirmation struct {
+	MilestoneIndex milestone.Index `json:"milestoneIndex"`
+}
+
+// GetWhiteFlagConfirmationResponse struct.
+type GetWhiteFlagConfirmationResponse struct {
+	MilestoneBundle []trinary.Trytes   `json:"milestoneBundle"`
+	IncludedBundles [][]trinary.Trytes `json:"includedBundles"`
+	Duration        int                `json:"duration"`
+}
+
+/////////////////// searchConfirmedApprover ////////////////////////
+
+// SearchConfirmedApprover struct.
+type SearchConfirmedApprover struct {
+	TxHash          trinary.Hash `json:"txHash"`
+	SearchMilestone bool         `json:"searchMilestone"`
+}
+
+// SearchConfirmedApproverResponse struct.
+type SearchConfirmedApproverResponse struct {
+	ConfirmedTxHash           trinary.Hash    `json:"confirmedTxHash"`
+	ConfirmedByMilestoneIndex milestone.Index `json:"confirmedByMilestoneIndex"`
+	TanglePath                []trinary.Hash  `json:"tanglePath"`
+	TanglePathLength          int             `json:"tanglePathLength"`
+	Duration                  int             `json:"duration"`
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota.go/address"
+	"github.com/iotaledger/iota.go/consts"
 	"github.com/iotaledger/iota.go/guards"
 	"github.com/iotaledger/iota.go/trinary"
 )
@@ -21,17 +23,39 @@ func restoreBody(c echo.Context, bodyBytes []byte) {
 	c.Request().Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 }
 
-func parseAddressParam(c echo.Context) (hornet.Hash, error) {
-	addr := strings.ToUpper(c.Param(ParameterAddress))
-
-	// Check if address is valid
+// parseAddress validates the given 81 or 90 tryte address and returns its binary representation.
+// The checksum of 90 tryte addresses is verified.
+func parseAddress(addr trinary.Hash) (hornet.Hash, error) {
 	if err := address.ValidAddress(addr); err != nil {
+		if errors.Is(err, consts.ErrInvalidChecksum) {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address checksum provided: %s", addr)
+		}
+
 		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address hash provided: %s, error: %s", addr, err)
 	}
 
 	return hornet.HashFromAddressTrytes(addr), nil
 }
 
+// addressWithChecksum returns the given 81 tryte address with its checksum appended if requested.
+func addressWithChecksum(addr trinary.Hash, withChecksum bool) trinary.Hash {
+	if !withChecksum || len(addr) != consts.HashTrytesSize {
+		return addr
+	}
+
+	checksum, err := address.Checksum(addr)
+	if err != nil {
+		// can't happen for valid 81 tryte addresses
+		return addr
+	}
+
+	return addr + checksum
+}
+
+func parseAddressParam(c echo.Context) (hornet.Hash, error) {
+	return parseAddress(strings.ToUpper(c.Param(ParameterAddress)))
+}
+
 func parseTransactionHashParam(c echo.Context) (hornet.Hash, error) {
 	txHash := strings.ToUpper(c.Param(ParameterTransactionHash))
 
@@ -42,6 +66,16 @@ func parseTransactionHashParam(c echo.Context) (hornet.Hash, error) {
 	return hornet.HashFromHashTrytes(txHash), nil
 }
 
+func parseBundleHashParam(c echo.Context) (hornet.Hash, error) {
+	bundleHash := strings.ToUpper(c.Param(ParameterBundleHash))
+
+	if !guards.IsTransactionHash(bundleHash) {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid bundle hash provided: %s", bundleHash)
+	}
+
+	return hornet.HashFromHashTrytes(bundleHash), nil
+}
+
 func parseBundleQueryParam(c echo.Context) (hornet.Hash, error) {
 	value := strings.ToUpper(c.QueryParam(QueryParameterBundle))
 
@@ -74,9 +108,19 @@ func parseAddressQueryParam(c echo.Context) (hornet.Hash, error) {
 	value := strings.ToUpper(c.QueryParam(QueryParameterAddress))
 
 	if len(value) > 0 {
-		// Check if address is valid
+		return parseAddress(value)
+	}
+
+	return nil, nil
+}
+
+func parseCursorQueryParam(c echo.Context) (hornet.Hash, error) {
+	value := strings.ToUpper(c.QueryParam(QueryParameterCursor))
+
+	if len(value) > 0 {
+		// the cursor is the last address of the previous page
 		if err := address.ValidAddress(value); err != nil {
-			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address hash provided: %s, error: %s", value, err)
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid cursor provided: %s, error: %s", value, err)
 		}
 
 		return hornet.HashFromAddressTrytes(value), nil
@@ -122,3 +166,74 @@ func parseMaxResultsQueryParam(c echo.Context, maxResults int) (int, error) {
 
 	return maxResults, nil
 }
+
+func parseLimitQueryParam(c echo.Context, maxLimit int) (int, error) {
+	value := c.QueryParam(QueryParameterLimit)
+
+	if len(value) > 0 {
+		limit, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return 0, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid %s, error: %s", QueryParameterLimit, err)
+		}
+
+		if (limit > 0) && (int(limit) < maxLimit) {
+			maxLimit = int(limit)
+		}
+	}
+
+	return maxLimit, nil
+}
+
+// parseMilestoneIndexQueryParam returns the milestone index of the query parameter or 0 if it is not given.
+func parseMilestoneIndexQueryParam(c echo.Context, paramName string) (milestone.Index, error) {
+	value := c.QueryParam(paramName)
+
+	if len(value) > 0 {
+		msIndex, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return 0, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid %s, error: %s", paramName, err)
+		}
+
+		return milestone.Index(msIndex), nil
+	}
+
+	return 0, nil
+}
+
+func parseMaxDepthQueryParam(c echo.Context, defaultDepth int, maxDepth int) (int, error) {
+	value := c.QueryParam(QueryParameterMaxDepth)
+
+	if len(value) > 0 {
+		requestMaxDepth, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return 0, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid %s, error: %s", QueryParameterMaxDepth, err)
+		}
+
+		if requestMaxDepth < 0 {
+			return 0, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid %s, error: must not be negative", QueryParameterMaxDepth)
+		}
+
+		if int(requestMaxDepth) < maxDepth {
+			return int(requestMaxDepth), nil
+		}
+
+		return maxDepth, nil
+	}
+
+	return defaultDepth, nil
+}
+
+func parseBoolQueryParam(c echo.Context, paramName string) (bool, error) {
+	value := c.QueryParam(paramName)
+
+	if len(value) > 0 {
+		result, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid %s, error: %s", paramName, err)
+		}
+
+		return result, nil
+	}
+
+	return false, nil
+}
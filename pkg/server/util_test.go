@@ -0,0 +1,77 @@
+package server
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/trinary"
+)
+
+// nullAddress is the address of 81 nines, nullAddressChecksum is its checksum.
+var (
+	nullAddress         = strings.Repeat("9", consts.HashTrytesSize)
+	nullAddressChecksum = "A9BEONKZW"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		address   trinary.Hash
+		wantError string
+	}{
+		{name: "81 trytes", address: nullAddress},
+		{name: "90 trytes", address: nullAddress + nullAddressChecksum},
+		{name: "bad checksum", address: nullAddress + "999999999", wantError: "invalid address checksum provided"},
+		{name: "too short", address: nullAddress[1:], wantError: "invalid address hash provided"},
+		{name: "between 81 and 90 trytes", address: nullAddress + "A9BE", wantError: "invalid address hash provided"},
+		{name: "too long", address: nullAddress + nullAddressChecksum + "9", wantError: "invalid address hash provided"},
+		{name: "invalid trytes", address: strings.Repeat("a", consts.HashTrytesSize), wantError: "invalid address hash provided"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := parseAddress(tt.address)
+
+			if tt.wantError != "" {
+				if !errors.Is(err, httpserver.ErrInvalidParameter) || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("got error %v, want %q", err, tt.wantError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the checksum is not part of the binary representation
+			if got := hash.Trytes(); got != nullAddress {
+				t.Fatalf("got address %s, want %s", got, nullAddress)
+			}
+		})
+	}
+}
+
+func TestAddressWithChecksum(t *testing.T) {
+	tests := []struct {
+		name         string
+		address      trinary.Hash
+		withChecksum bool
+		want         trinary.Hash
+	}{
+		{name: "without checksum", address: nullAddress, want: nullAddress},
+		{name: "with checksum", address: nullAddress, withChecksum: true, want: nullAddress + nullAddressChecksum},
+		{name: "address already has a checksum", address: nullAddress + nullAddressChecksum, withChecksum: true, want: nullAddress + nullAddressChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addressWithChecksum(tt.address, tt.withChecksum); got != tt.want {
+				t.Fatalf("got address %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota.go/transaction"
+	"github.com/iotaledger/iota.go/trinary"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v0/pkg/milestone"
+)
+
+// whiteFlagEntry is a transaction on the stack of the white-flag walk.
+type whiteFlagEntry struct {
+	txMeta   *database.TransactionMetadata
+	expanded bool
+}
+
+// whiteFlagIncludedTails walks the past cone of the milestone and returns the tails of the bundles
+// that mutated the ledger in the order in which they were applied.
+// Like the white-flag confirmation of Hornet, the cone is walked depth-first in post-order, trunk before branch,
+// so a bundle is always applied after the bundles it references.
+func whiteFlagIncludedTails(ctx context.Context, db *database.Database, milestoneIndex milestone.Index, milestoneTailHash hornet.Hash) (hornet.Hashes, error) {
+	ctx, done := db.TrackMethod(ctx, "whiteFlagIncludedTails", database.AttributeMilestoneIndex.Int64(int64(milestoneIndex)))
+	keysIterated := 0
+	includedTails := hornet.Hashes{}
+	defer func() { done(keysIterated, database.AttributeResultCount.Int(len(includedTails))) }()
+
+	visited := make(map[string]struct{})
+
+	// push adds the transaction to the stack if it belongs to the cone of the milestone
+	var stack []*whiteFlagEntry
+	push := func(txHash hornet.Hash) error {
+		if _, seen := visited[string(txHash)]; seen {
+			return nil
+		}
+		visited[string(txHash)] = struct{}{}
+
+		if db.SolidEntryPointsContain(txHash) {
+			// do not walk beyond solid entry points
+			return nil
+		}
+
+		txMeta := db.GetTxMetadataOrNilContext(ctx, txHash)
+		keysIterated++
+		if txMeta == nil {
+			return fmt.Errorf("transaction not found: %s", txHash.Trytes())
+		}
+
+		confirmed, at := txMeta.GetConfirmed()
+		if !confirmed {
+			return fmt.Errorf("transaction not confirmed yet: %s", txHash.Trytes())
+		}
+
+		if at != milestoneIndex {
+			// ignore all transactions that were confirmed by another milestone
+			return nil
+		}
+
+		stack = append(stack, &whiteFlagEntry{txMeta: txMeta})
+
+		return nil
+	}
+
+	if err := push(milestoneTailHash); err != nil {
+		return nil, err
+	}
+
+	for len(stack) > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, database.ErrOperationAborted
+		default:
+		}
+
+		entry := stack[len(stack)-1]
+
+		if !entry.expanded {
+			entry.expanded = true
+
+			// the branch is pushed first, so the trunk is walked first
+			for _, approveeHash := range (hornet.Hashes{entry.txMeta.GetBranchHash(), entry.txMeta.GetTrunkHash()}) {
+				if err := push(approveeHash); err != nil {
+					return nil, err
+				}
+			}
+
+			continue
+		}
+
+		// all approvees were walked, the transaction itself can be applied
+		stack = stack[:len(stack)-1]
+
+		txHash := entry.txMeta.GetTxHash()
+		if !entry.txMeta.IsTail() || entry.txMeta.IsConflicting() || string(txHash) == string(milestoneTailHash) {
+			continue
+		}
+
+		bndl := db.GetBundleOrNilContext(ctx, txHash)
+		keysIterated++
+		if bndl == nil {
+			return nil, fmt.Errorf("bundle not found: %s", txHash.Trytes())
+		}
+
+		if !bndl.IsValid() {
+			return nil, fmt.Errorf("bundle not valid: %s", txHash.Trytes())
+		}
+
+		if bndl.IsValueSpam() {
+			// zero value bundles don't mutate the ledger
+			continue
+		}
+
+		includedTails = append(includedTails, txHash)
+	}
+
+	return includedTails, nil
+}
+
+// bundleTrytes returns the trytes of the transactions of the bundle ordered by their index in the bundle.
+func bundleTrytes(bndl *database.Bundle) ([]trinary.Trytes, error) {
+	txs := bndl.GetTransactions()
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Tx.CurrentIndex < txs[j].Tx.CurrentIndex
+	})
+
+	trytes := make([]trinary.Trytes, 0, len(txs))
+	for _, tx := range txs {
+		txTrytes, err := transaction.TransactionToTrytes(tx.Tx)
+		if err != nil {
+			return nil, err
+		}
+		trytes = append(trytes, txTrytes)
+	}
+
+	return trytes, nil
+}
+
+// whiteFlagConfirmation returns the trytes of the milestone bundle and of the bundles that were
+// confirmed by the milestone in white-flag order.
+func (s *DatabaseServer) whiteFlagConfirmation(ctx context.Context, milestoneIndex milestone.Index) ([]trinary.Trytes, [][]trinary.Trytes, error) {
+	smi := s.Database.GetSolidMilestoneIndex()
+	if milestoneIndex > smi {
+		return nil, nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid milestone index: %d, lsmi is %d", milestoneIndex, smi)
+	}
+
+	msBndl := s.Database.GetMilestoneBundleOrNilContext(ctx, milestoneIndex)
+	if msBndl == nil {
+		return nil, nil, errors.WithMessagef(echo.ErrNotFound, "milestone not found: %d", milestoneIndex)
+	}
+
+	milestoneBundle, err := bundleTrytes(msBndl)
+	if err != nil {
+		return nil, nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	includedTails, err := whiteFlagIncludedTails(ctx, s.Database, milestoneIndex, msBndl.GetTailHash())
+	if err != nil {
+		return nil, nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+	}
+
+	includedBundles := make([][]trinary.Trytes, 0, len(includedTails))
+	for _, tailTxHash := range includedTails {
+		bndl := s.Database.GetBundleOrNilContext(ctx, tailTxHash)
+		if bndl == nil {
+			return nil, nil, errors.WithMessagef(echo.ErrInternalServerError, "bundle not found: %s", tailTxHash.Trytes())
+		}
+
+		trytes, err := bundleTrytes(bndl)
+		if err != nil {
+			return nil, nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+		}
+		includedBundles = append(includedBundles, trytes)
+	}
+
+	return milestoneBundle, includedBundles, nil
+}
+
+func (s *DatabaseServer) rpcGetWhiteFlagConfirmation(c echo.Context) (interface{}, error) {
+	request := &GetWhiteFlagConfirmation{}
+	if err := c.Bind(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	milestoneBundle, includedBundles, err := s.whiteFlagConfirmation(c.Request().Context(), request.MilestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWhiteFlagConfirmationResponse{
+		MilestoneBundle: milestoneBundle,
+		IncludedBundles: includedBundles,
+	}, nil
+}
+
+func (s *DatabaseServer) milestoneWhiteFlagConfirmation(c echo.Context) (interface{}, error) {
+	msIndexIotaGo, err := httpserver.ParseMilestoneIndexParam(c, ParameterMilestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+	msIndex := milestone.Index(msIndexIotaGo)
+
+	milestoneBundle, includedBundles, err := s.whiteFlagConfirmation(c.Request().Context(), msIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &whiteFlagConfirmationResponse{
+		MilestoneIndex:  msIndex,
+		MilestoneBundle: milestoneBundle,
+		IncludedBundles: includedBundles,
+		LedgerIndex:     s.Database.GetLedgerIndex(),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+
+	"go.uber.org/dig"
+	"google.golang.org/grpc"
+
+	"github.com/iotaledger/hive.go/core/app"
+	"github.com/iotaledger/inx-api-core-v0/pkg/admission"
+	"github.com/iotaledger/inx-api-core-v0/pkg/auth"
+	"github.com/iotaledger/inx-api-core-v0/pkg/daemon"
+	"github.com/iotaledger/inx-api-core-v0/pkg/legacyapi"
+	"github.com/iotaledger/inx-api-core-v0/pkg/ratelimit"
+	"github.com/iotaledger/inx-api-core-v0/pkg/server"
+)
+
+func init() {
+	Plugin = &app.Plugin{
+		Component: &app.Component{
+			Name:     "GRPCAPI",
+			DepsFunc: func(cDeps dependencies) { deps = cDeps },
+			Params:   params,
+			Run:      run,
+		},
+		IsEnabled: func() bool {
+			return ParamsGRPCAPI.Enabled
+		},
+	}
+}
+
+type dependencies struct {
+	dig.In
+	DatabaseServer                 *server.DatabaseServer
+	RestAPIRateLimitEnabled        bool                            `name:"restAPIRateLimitEnabled"`
+	RestAPIAuthenticationEnabled   bool                            `name:"restAPIAuthenticationEnabled"`
+	RestAPIAdmissionControlEnabled bool                            `name:"restAPIAdmissionControlEnabled"`
+	RestAPIOperationClassCost      func(server.OperationClass) int `name:"restAPIOperationClassCost"`
+	RateLimiter                    *ratelimit.RateLimiter
+	Authenticator                  *auth.Authenticator
+	AdmissionController            *admission.Controller
+}
+
+var (
+	Plugin *app.Plugin
+	deps   dependencies
+)
+
+func run() error {
+	// the gRPC API is protected by the same limits as the REST API
+	g := &guard{operationClassCost: deps.RestAPIOperationClassCost}
+	if deps.RestAPIRateLimitEnabled {
+		g.rateLimiter = deps.RateLimiter
+	}
+	if deps.RestAPIAuthenticationEnabled {
+		g.authenticator = deps.Authenticator
+	}
+	if deps.RestAPIAdmissionControlEnabled {
+		g.admissionController = deps.AdmissionController
+	}
+
+	if err := Plugin.Daemon().BackgroundWorker("gRPC API", func(ctx context.Context) {
+		Plugin.LogInfo("Starting gRPC API server ...")
+
+		listener, err := net.Listen("tcp", ParamsGRPCAPI.BindAddress)
+		if err != nil {
+			Plugin.LogErrorfAndExit("failed to listen on %s: %s", ParamsGRPCAPI.BindAddress, err)
+		}
+
+		grpcServer := grpc.NewServer(
+			grpc.ChainUnaryInterceptor(g.unaryInterceptor),
+			grpc.ChainStreamInterceptor(g.streamInterceptor),
+		)
+		legacyapi.RegisterLegacyAPIServer(grpcServer, server.NewGRPCServer(deps.DatabaseServer))
+
+		go func() {
+			Plugin.LogInfof("You can now access the gRPC API using: %s", ParamsGRPCAPI.BindAddress)
+			if err := grpcServer.Serve(listener); err != nil {
+				Plugin.LogErrorfAndExit("Stopped gRPC API server due to an error (%s)", err)
+			}
+		}()
+
+		Plugin.LogInfo("Starting gRPC API server ... done")
+		<-ctx.Done()
+		Plugin.LogInfo("Stopping gRPC API server ...")
+
+		grpcServer.GracefulStop()
+
+		Plugin.LogInfo("Stopping gRPC API server ... done")
+	}, daemon.PriorityStopGRPCAPI); err != nil {
+		Plugin.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/admission"
+	"github.com/iotaledger/inx-api-core-v0/pkg/auth"
+	"github.com/iotaledger/inx-api-core-v0/pkg/ratelimit"
+	"github.com/iotaledger/inx-api-core-v0/pkg/server"
+)
+
+const (
+	// metadataAPIKey is the metadata key that contains the static API key of a client.
+	metadataAPIKey = "x-api-key"
+	// metadataAuthorization is the metadata key that contains the bearer token of a client.
+	metadataAuthorization = "authorization"
+)
+
+// guard applies the rate limiter, the authentication and the admission control of the REST API to gRPC calls.
+// Components that are disabled in the REST API are nil.
+type guard struct {
+	rateLimiter         *ratelimit.RateLimiter
+	authenticator       *auth.Authenticator
+	admissionController *admission.Controller
+	operationClassCost  func(server.OperationClass) int
+}
+
+// metadataValue returns the first value of the given key in the incoming metadata.
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// peerIP returns the IP of the client of the call.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// admit checks a call in the same order as the middlewares of the REST API.
+// The returned function releases the slot of the admission control and must be called after the call was processed.
+func (g *guard) admit(ctx context.Context, fullMethod string) (func(), error) {
+	class := server.OperationClassForGRPCMethod(fullMethod)
+	apiKey := metadataValue(ctx, metadataAPIKey)
+
+	if g.rateLimiter != nil {
+		if _, err := g.rateLimiter.Allow(fullMethod, peerIP(ctx), apiKey, g.operationClassCost(class)); err != nil {
+			if errors.Is(err, ratelimit.ErrCostExceedsBurst) {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+	}
+
+	if g.authenticator != nil {
+		scope := server.PermissionScopeForGRPCMethod(fullMethod)
+		if err := g.authenticator.Authorize(apiKey, metadataValue(ctx, metadataAuthorization), []string{string(scope)}); err != nil {
+			if errors.Is(err, auth.ErrForbidden) {
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			}
+
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+	}
+
+	if g.admissionController != nil {
+		release, err := g.admissionController.Acquire(ctx, string(class))
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return nil, status.FromContextError(err).Err()
+			}
+
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		return release, nil
+	}
+
+	return func() {}, nil
+}
+
+func (g *guard) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	release, err := g.admit(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return handler(ctx, req)
+}
+
+func (g *guard) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, err := g.admit(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return handler(srv, ss)
+}
@@ -0,0 +1,122 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/admission"
+	"github.com/iotaledger/inx-api-core-v0/pkg/auth"
+	"github.com/iotaledger/inx-api-core-v0/pkg/ratelimit"
+	"github.com/iotaledger/inx-api-core-v0/pkg/server"
+)
+
+const (
+	methodGetNodeInfo    = "/legacyapi.LegacyAPI/GetNodeInfo"
+	methodGetLedgerState = "/legacyapi.LegacyAPI/GetLedgerState"
+)
+
+func callContext(ip string, md ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(md...))
+}
+
+func newTestGuard(t *testing.T) *guard {
+	t.Helper()
+
+	costs := map[server.OperationClass]int{
+		server.OperationClassDefault:     1,
+		server.OperationClassLedgerState: 10,
+	}
+
+	controller := admission.New(time.Millisecond, nil)
+	if err := controller.AddQueue(string(server.OperationClassLedgerState), 1, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	return &guard{
+		rateLimiter:         ratelimit.New(ratelimit.NewLimiter(1, 10), ratelimit.NewLimiter(1, 10), []string{"key"}, nil),
+		authenticator:       auth.New([]string{"key"}, "", []string{string(server.PermissionScopeInfo)}, nil),
+		admissionController: controller,
+		operationClassCost:  func(class server.OperationClass) int { return costs[class] },
+	}
+}
+
+func TestGuardAdmit(t *testing.T) {
+	type call struct {
+		ctx      context.Context
+		method   string
+		wantCode codes.Code
+	}
+
+	tests := []struct {
+		name  string
+		calls []call
+	}{
+		{
+			name: "public method",
+			calls: []call{
+				{ctx: callContext("1.1.1.1"), method: methodGetNodeInfo, wantCode: codes.OK},
+			},
+		},
+		{
+			name: "protected method without credentials",
+			calls: []call{
+				{ctx: callContext("1.1.1.1"), method: methodGetLedgerState, wantCode: codes.Unauthenticated},
+			},
+		},
+		{
+			name: "protected method with api key",
+			calls: []call{
+				{ctx: callContext("1.1.1.1", metadataAPIKey, "key"), method: methodGetLedgerState, wantCode: codes.OK},
+			},
+		},
+		{
+			name: "rate limited by ip",
+			calls: []call{
+				{ctx: callContext("1.1.1.1"), method: methodGetLedgerState, wantCode: codes.Unauthenticated},
+				{ctx: callContext("1.1.1.1"), method: methodGetNodeInfo, wantCode: codes.ResourceExhausted},
+				{ctx: callContext("2.2.2.2"), method: methodGetNodeInfo, wantCode: codes.OK},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGuard(t)
+
+			for i, c := range tt.calls {
+				release, err := g.admit(c.ctx, c.method)
+				if code := status.Code(err); code != c.wantCode {
+					t.Fatalf("call %d: got code %s, want %s (%v)", i, code, c.wantCode, err)
+				}
+
+				if err == nil {
+					release()
+				}
+			}
+		})
+	}
+}
+
+func TestGuardAdmitAdmissionControl(t *testing.T) {
+	g := newTestGuard(t)
+	g.rateLimiter = nil
+
+	release, err := g.admit(callContext("1.1.1.1", metadataAPIKey, "key"), methodGetLedgerState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	if _, err := g.admit(callContext("2.2.2.2", metadataAPIKey, "key"), methodGetLedgerState); status.Code(err) != codes.Unavailable {
+		t.Fatalf("got code %s, want %s (%v)", status.Code(err), codes.Unavailable, err)
+	}
+}
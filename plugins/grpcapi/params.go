@@ -0,0 +1,22 @@
+package grpcapi
+
+import (
+	"github.com/iotaledger/hive.go/core/app"
+)
+
+// ParametersGRPCAPI contains the definition of the parameters used by the legacy API gRPC server.
+type ParametersGRPCAPI struct {
+	// Enabled defines whether the legacy API gRPC server is enabled.
+	Enabled bool `default:"false" usage:"whether the legacy API gRPC server is enabled"`
+	// BindAddress defines the bind address on which the legacy API gRPC server listens.
+	BindAddress string `default:"localhost:9094" usage:"the bind address on which the legacy API gRPC server listens"`
+}
+
+var ParamsGRPCAPI = &ParametersGRPCAPI{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"grpcAPI": ParamsGRPCAPI,
+	},
+	Masked: nil,
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/iotaledger/hive.go/core/app"
 	"github.com/iotaledger/hive.go/core/app/pkg/shutdown"
 	"github.com/iotaledger/inx-api-core-v0/pkg/daemon"
+	"github.com/iotaledger/inx-api-core-v0/pkg/health"
 	"github.com/iotaledger/inx-app/pkg/nodebridge"
+	inx "github.com/iotaledger/inx/go"
 )
 
 const (
@@ -36,6 +38,7 @@ func init() {
 type dependencies struct {
 	dig.In
 	NodeBridge              *nodebridge.NodeBridge
+	HealthChecker           *health.Checker
 	ShutdownHandler         *shutdown.ShutdownHandler
 	RestAPIBindAddress      string `name:"restAPIBindAddress"`
 	RestAPIAdvertiseAddress string `name:"restAPIAdvertiseAddress"`
@@ -61,6 +64,17 @@ func configure() error {
 		Plugin.LogErrorfAndExit("failed to connect via INX: %s", err.Error())
 	}
 
+	deps.HealthChecker.AddReadinessCheck("inx", checkConnection)
+
+	return nil
+}
+
+// checkConnection checks that the node is still reachable via INX.
+func checkConnection(ctx context.Context) error {
+	if _, err := deps.NodeBridge.Client().ReadNodeStatus(ctx, &inx.NoParams{}); err != nil {
+		return errors.Wrap(err, "node is not reachable via INX")
+	}
+
 	return nil
 }
 
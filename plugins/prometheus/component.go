@@ -16,7 +16,12 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/hive.go/core/app"
+	"github.com/iotaledger/inx-api-core-v0/pkg/admission"
+	"github.com/iotaledger/inx-api-core-v0/pkg/coalesce"
 	"github.com/iotaledger/inx-api-core-v0/pkg/daemon"
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/engine"
+	"github.com/iotaledger/inx-api-core-v0/pkg/ratelimit"
 )
 
 func init() {
@@ -37,13 +42,20 @@ func init() {
 
 type dependencies struct {
 	dig.In
-	Echo           *echo.Echo
-	PrometheusEcho *echo.Echo `name:"prometheusEcho"`
+	PrometheusEcho      *echo.Echo `name:"prometheusEcho"`
+	Database            *database.Database
+	DatabaseStores      map[string]*engine.Store `name:"databaseStores"`
+	RateLimiter         *ratelimit.RateLimiter
+	Coalescer           *coalesce.Coalescer
+	AdmissionController *admission.Controller
 }
 
 var (
 	Plugin *app.Plugin
 	deps   dependencies
+
+	// restAPIMetrics holds the request metrics of the REST API, if they are enabled.
+	restAPIMetrics *echoprometheus.Prometheus
 )
 
 func provide(c *dig.Container) error {
@@ -51,6 +63,10 @@ func provide(c *dig.Container) error {
 	type depsOut struct {
 		dig.Out
 		PrometheusEcho *echo.Echo `name:"prometheusEcho"`
+		// the REST API registers the metrics middlewares before its own middlewares,
+		// so requests that are rejected by the rate limiter, the authentication or the admission control are counted as well.
+		RestAPIMetricsMiddleware    echo.MiddlewareFunc `name:"restAPIMetricsMiddleware"`
+		RestAPIRPCMetricsMiddleware echo.MiddlewareFunc `name:"restAPIRPCMetricsMiddleware"`
 	}
 
 	return c.Provide(func() depsOut {
@@ -58,9 +74,17 @@ func provide(c *dig.Container) error {
 		e.HideBanner = true
 		e.Use(middleware.Recover())
 
-		return depsOut{
+		out := depsOut{
 			PrometheusEcho: e,
 		}
+
+		if ParamsPrometheus.RestAPIMetrics {
+			restAPIMetrics = echoprometheus.NewPrometheus("iota_restapi", nil)
+			out.RestAPIMetricsMiddleware = restAPIMetrics.HandlerFunc
+			out.RestAPIRPCMetricsMiddleware = rpcCommandMetricsMiddleware
+		}
+
+		return out
 	})
 }
 
@@ -127,16 +151,24 @@ func registerMetrics() *prometheus.Registry {
 		registry.MustRegister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
 	}
 
+	if ParamsPrometheus.DatabaseMetrics {
+		registry.MustRegister(deps.Database.Collectors()...)
+		registry.MustRegister(newDatabaseEngineCollector(deps.DatabaseStores))
+	}
+
 	if ParamsPrometheus.INXMetrics {
 		registry.MustRegister(grpcprometheus.DefaultClientMetrics)
 	}
 
 	if ParamsPrometheus.RestAPIMetrics {
-		p := echoprometheus.NewPrometheus("iota_restapi", nil)
-		for _, m := range p.MetricsList {
+		for _, m := range restAPIMetrics.MetricsList {
 			registry.MustRegister(m.MetricCollector)
 		}
-		deps.Echo.Use(p.HandlerFunc)
+		registry.MustRegister(rpcRequests)
+
+		registry.MustRegister(deps.RateLimiter.Collectors()...)
+		registry.MustRegister(deps.Coalescer.Collectors()...)
+		registry.MustRegister(deps.AdmissionController.Collectors()...)
 	}
 
 	return registry
@@ -0,0 +1,37 @@
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database/engine"
+)
+
+// databaseEngineCollector collects the engine-level statistics of the databases on every scrape.
+type databaseEngineCollector struct {
+	stores map[string]*engine.Store
+	desc   *prometheus.Desc
+}
+
+func newDatabaseEngineCollector(stores map[string]*engine.Store) *databaseEngineCollector {
+	return &databaseEngineCollector{
+		stores: stores,
+		desc: prometheus.NewDesc(
+			"iota_database_engine_stats",
+			"The engine-level statistics of the databases.",
+			[]string{"database", "engine", "stat"},
+			nil,
+		),
+	}
+}
+
+func (c *databaseEngineCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *databaseEngineCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, store := range c.stores {
+		for stat, value := range store.Stats() {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, value, name, string(store.Engine), stat)
+		}
+	}
+}
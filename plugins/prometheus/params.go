@@ -16,6 +16,8 @@ type ParametersPrometheus struct {
 	ProcessMetrics bool `default:"false" usage:"whether to include process metrics"`
 	// RestAPIMetrics include restAPI metrics.
 	RestAPIMetrics bool `default:"true" usage:"whether to include restAPI metrics"`
+	// DatabaseMetrics defines whether to include database metrics.
+	DatabaseMetrics bool `default:"true" usage:"whether to include database metrics"`
 	// INXMetrics defines whether to include INXMetrics metrics.
 	INXMetrics bool `name:"inxMetrics" default:"true" usage:"whether to include INX metrics"`
 	// PromhttpMetrics defines whether to include promhttp metrics.
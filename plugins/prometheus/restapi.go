@@ -0,0 +1,63 @@
+package prometheus
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/server"
+)
+
+const (
+	rpcCommandUnknown = "unknown"
+	rpcCommandBatch   = "batch"
+)
+
+var (
+	rpcRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "iota",
+			Subsystem: "restapi",
+			Name:      "rpc_requests_total",
+			Help:      "The number of RPC requests per command and status code.",
+		},
+		[]string{"command", "code"},
+	)
+)
+
+// rpcCommandMetricsMiddleware counts the RPC requests per command,
+// because the echo request metrics count all of them under the same route.
+func rpcCommandMetricsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Path() != server.RouteRPCEndpoint && c.Path() != server.RouteJSONRPCEndpoint {
+			return next(c)
+		}
+
+		command := server.PeekRPCCommand(c)
+		if _, isBatch := server.PeekRPCBatchCommands(c); isBatch {
+			command = rpcCommandBatch
+		} else if !server.IsKnownRPCCommand(command) {
+			// avoid creating a new label for every random command
+			command = rpcCommandUnknown
+		}
+
+		err := next(c)
+
+		status := c.Response().Status
+		if err != nil {
+			status = http.StatusInternalServerError
+
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				status = httpErr.Code
+			}
+		}
+
+		rpcRequests.WithLabelValues(command, strconv.Itoa(status)).Inc()
+
+		return err
+	}
+}
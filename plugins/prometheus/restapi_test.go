@@ -0,0 +1,63 @@
+package prometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/server"
+)
+
+func TestRPCCommandMetricsMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		reject      bool
+		wantCommand string
+		wantStatus  int
+	}{
+		{name: "known command", body: `{"command":"getNodeInfo"}`, wantCommand: "getnodeinfo", wantStatus: http.StatusOK},
+		{name: "rejected request is counted", body: `{"command":"getNodeInfo"}`, reject: true, wantCommand: "getnodeinfo", wantStatus: http.StatusTooManyRequests},
+		{name: "unknown command", body: `{"command":"random"}`, wantCommand: rpcCommandUnknown, wantStatus: http.StatusOK},
+		{name: "batch request", body: `[{"command":"getNodeInfo"}]`, wantCommand: rpcCommandBatch, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(rpcCommandMetricsMiddleware)
+			// the rejecting middlewares of the REST API are registered after the metrics middleware
+			e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					if tt.reject {
+						return echo.ErrTooManyRequests
+					}
+
+					return next(c)
+				}
+			})
+			e.POST(server.RouteRPCEndpoint, func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+			counter := rpcRequests.WithLabelValues(tt.wantCommand, strconv.Itoa(tt.wantStatus))
+			before := testutil.ToFloat64(counter)
+
+			req := httptest.NewRequest(http.MethodPost, server.RouteRPCEndpoint, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			if count := testutil.ToFloat64(counter) - before; count != 1 {
+				t.Fatalf("got %f requests of command %q with status %d, want 1", count, tt.wantCommand, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/core/app"
+	"github.com/iotaledger/inx-api-core-v0/pkg/daemon"
+)
+
+func init() {
+	Plugin = &app.Plugin{
+		Component: &app.Component{
+			Name:      "Tracing",
+			DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+			Params:    params,
+			Provide:   provide,
+			Configure: configure,
+			Run:       run,
+		},
+		IsEnabled: func() bool {
+			return ParamsTracing.Enabled
+		},
+	}
+}
+
+type dependencies struct {
+	dig.In
+	AppInfo *app.Info
+}
+
+var (
+	Plugin *app.Plugin
+	deps   dependencies
+
+	tracerProvider *sdktrace.TracerProvider
+)
+
+// newExporter creates an exporter that sends the recorded spans to the OTLP gRPC collector at the given endpoint.
+// The connection is established lazily, so a missing collector doesn't prevent the startup.
+func newExporter(endpoint string, insecure bool) (sdktrace.SpanExporter, error) {
+	options := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(endpoint),
+	}
+	if insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	return otlptracegrpc.New(context.Background(), options...)
+}
+
+// NewTracerProvider creates a TracerProvider that samples the given ratio of traces and sends them to the given exporter.
+// Within the sampled traces, only the given ratio of the spans of database lookups is recorded.
+func NewTracerProvider(exporter sdktrace.SpanExporter, serviceName string, serviceVersion string, samplingRatio float64, lookupSamplingRatio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		// traces started by the caller are sampled according to the decision of the caller
+		sdktrace.WithSampler(newLookupSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio)), lookupSamplingRatio)),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceVersionKey.String(serviceVersion),
+		)),
+	)
+}
+
+func provide(c *dig.Container) error {
+
+	type depsOut struct {
+		dig.Out
+		TracingMiddleware echo.MiddlewareFunc `name:"restAPITracingMiddleware"`
+	}
+
+	// the REST API registers the middleware before its own middlewares, so rejected requests are traced as well.
+	// the middleware uses the global tracer provider, which delegates to the provider that is set in configure.
+	return c.Provide(func() depsOut {
+		return depsOut{
+			TracingMiddleware: otelecho.Middleware(ParamsTracing.ServiceName),
+		}
+	})
+}
+
+func configure() error {
+	exporter, err := newExporter(ParamsTracing.OTLP.Endpoint, ParamsTracing.OTLP.Insecure)
+	if err != nil {
+		Plugin.LogErrorfAndExit("failed to create OTLP exporter: %s", err)
+	}
+
+	tracerProvider = NewTracerProvider(exporter, ParamsTracing.ServiceName, deps.AppInfo.Version, ParamsTracing.SamplingRatio, ParamsTracing.DatabaseLookupSamplingRatio)
+
+	// the tracers of the packages delegate to the global provider
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return nil
+}
+
+func run() error {
+	if err := Plugin.Daemon().BackgroundWorker("Tracing", func(ctx context.Context) {
+		Plugin.LogInfof("Exporting traces to %s", ParamsTracing.OTLP.Endpoint)
+		<-ctx.Done()
+		Plugin.LogInfo("Stopping Tracing ...")
+
+		shutdownCtx, shutdownCtxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCtxCancel()
+
+		// flush the remaining spans
+		//nolint:contextcheck // false positive
+		if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+			Plugin.LogWarn(err)
+		}
+
+		Plugin.LogInfo("Stopping Tracing ... done")
+	}, daemon.PriorityStopTracing); err != nil {
+		Plugin.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
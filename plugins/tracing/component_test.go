@@ -0,0 +1,146 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+)
+
+// testCollector is an in-process OTLP gRPC collector that stores the names of the received spans.
+type testCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	lock      sync.Mutex
+	spanNames []string
+}
+
+func (c *testCollector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, resourceSpans := range req.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			for _, span := range scopeSpans.GetSpans() {
+				c.spanNames = append(c.spanNames, span.GetName())
+			}
+		}
+	}
+
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (c *testCollector) SpanNames() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	spanNames := append([]string{}, c.spanNames...)
+	sort.Strings(spanNames)
+
+	return spanNames
+}
+
+// startTestCollector starts a collector on a random local port and returns it with its endpoint.
+func startTestCollector(t *testing.T) (*testCollector, string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collector := &testCollector{}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, collector)
+
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return collector, listener.Addr().String()
+}
+
+func TestTracerProviderExportsToCollector(t *testing.T) {
+	tests := []struct {
+		name                string
+		samplingRatio       float64
+		lookupSamplingRatio float64
+		wantSpanNames       []string
+	}{
+		{
+			name:                "all spans are recorded",
+			samplingRatio:       1,
+			lookupSamplingRatio: 1,
+			wantSpanNames:       []string{"/transactions", "Database.FindTransactions", "Database.GetTransactionOrNil"},
+		},
+		{
+			name:                "lookups are dropped",
+			samplingRatio:       1,
+			lookupSamplingRatio: 0,
+			wantSpanNames:       []string{"/transactions", "Database.FindTransactions"},
+		},
+		{
+			name:                "trace is not sampled",
+			samplingRatio:       0,
+			lookupSamplingRatio: 1,
+			wantSpanNames:       []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector, endpoint := startTestCollector(t)
+
+			exporter, err := newExporter(endpoint, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tracerProvider := NewTracerProvider(exporter, "test", "1.0.0", tt.samplingRatio, tt.lookupSamplingRatio)
+			tracer := tracerProvider.Tracer("test")
+
+			e := echo.New()
+			e.Use(otelecho.Middleware("test", otelecho.WithTracerProvider(tracerProvider)))
+			e.GET("/transactions", func(c echo.Context) error {
+				ctx, span := tracer.Start(c.Request().Context(), "Database.FindTransactions")
+				defer span.End()
+
+				_, lookupSpan := tracer.Start(ctx, "Database.GetTransactionOrNil", trace.WithAttributes(database.AttributeLookup.Bool(true)))
+				lookupSpan.End()
+
+				return c.NoContent(http.StatusOK)
+			})
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/transactions", nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+			}
+
+			// flushes the remaining spans to the collector
+			if err := tracerProvider.Shutdown(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			spanNames := collector.SpanNames()
+			if len(spanNames) != len(tt.wantSpanNames) {
+				t.Fatalf("got spans %v, want %v", spanNames, tt.wantSpanNames)
+			}
+			for i := range spanNames {
+				if spanNames[i] != tt.wantSpanNames[i] {
+					t.Fatalf("got spans %v, want %v", spanNames, tt.wantSpanNames)
+				}
+			}
+		})
+	}
+}
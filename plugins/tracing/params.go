@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"github.com/iotaledger/hive.go/core/app"
+)
+
+// ParametersTracing contains the definition of the parameters used by the tracing plugin.
+type ParametersTracing struct {
+	// Enabled defines whether the tracing plugin is enabled.
+	Enabled bool `default:"false" usage:"whether the tracing plugin is enabled"`
+	// ServiceName defines the service name that is attached to the recorded spans.
+	ServiceName string `default:"inx-api-core-v0" usage:"the service name that is attached to the recorded spans"`
+	// SamplingRatio defines the ratio of traces that are recorded.
+	SamplingRatio float64 `default:"1.0" usage:"the ratio of traces that are recorded (0.0 - 1.0)"`
+	// DatabaseLookupSamplingRatio defines the ratio of the spans of database lookups that are recorded in the sampled traces.
+	DatabaseLookupSamplingRatio float64 `default:"0.1" usage:"the ratio of the spans of database lookups that are recorded in the sampled traces (0.0 - 1.0)"`
+
+	OTLP struct {
+		// Endpoint defines the address of the OTLP gRPC collector the spans are exported to.
+		Endpoint string `default:"localhost:4317" usage:"the address of the OTLP gRPC collector the spans are exported to"`
+		// Insecure defines whether the connection to the collector is not secured by TLS.
+		Insecure bool `default:"true" usage:"whether the connection to the collector is not secured by TLS"`
+	} `name:"otlp"`
+}
+
+var ParamsTracing = &ParametersTracing{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"tracing": ParamsTracing,
+	},
+	Masked: nil,
+}
@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/iotaledger/inx-api-core-v0/pkg/database"
+)
+
+// lookupSampler records only a ratio of the spans of database lookups.
+// A walk through the tangle does a lookup for every visited transaction,
+// so recording all of them would flood the collector with spans of the same few methods.
+type lookupSampler struct {
+	base  sdktrace.Sampler
+	ratio float64
+}
+
+// newLookupSampler returns a sampler that decides with the given base sampler
+// and drops all but the given ratio of the sampled spans of database lookups.
+func newLookupSampler(base sdktrace.Sampler, ratio float64) sdktrace.Sampler {
+	return &lookupSampler{
+		base:  base,
+		ratio: ratio,
+	}
+}
+
+func (s *lookupSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := s.base.ShouldSample(p)
+	if result.Decision != sdktrace.RecordAndSample || !isLookup(p) {
+		return result
+	}
+
+	// the lookups of a trace are sampled independently, so the recorded spans are spread over the whole walk
+	//nolint:gosec // sampling doesn't need a secure random number generator
+	if rand.Float64() < s.ratio {
+		return result
+	}
+
+	return sdktrace.SamplingResult{
+		Decision:   sdktrace.Drop,
+		Tracestate: result.Tracestate,
+	}
+}
+
+func (s *lookupSampler) Description() string {
+	return fmt.Sprintf("LookupSampler{%s,ratio:%g}", s.base.Description(), s.ratio)
+}
+
+// isLookup returns whether the span is the lookup of a single database entry.
+func isLookup(p sdktrace.SamplingParameters) bool {
+	for _, attribute := range p.Attributes {
+		if attribute.Key == database.AttributeLookup {
+			return attribute.Value.AsBool()
+		}
+	}
+
+	return false
+}
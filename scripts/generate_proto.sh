@@ -0,0 +1,9 @@
+#!/bin/bash
+#
+# Generates the gRPC code of the legacy API
+# Requires protoc, protoc-gen-go and protoc-gen-go-grpc
+
+DIR="$( cd -- "$(dirname "$0")" >/dev/null 2>&1 ; pwd -P )"
+
+cd "${DIR}/../pkg/legacyapi" || exit 1
+protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative legacyapi.proto
@@ -65,6 +65,7 @@ func main() {
 	replaceTopicNames["log"] = "Shutdown Log"
 	replaceTopicNames["db"] = "Database"
 	replaceTopicNames["inx"] = "INX"
+	replaceTopicNames["grpcAPI"] = "gRPC API"
 
 	application := apiCoreV0App.App()
 